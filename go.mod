@@ -1,5 +1,13 @@
 module github.com/bradleygore/go-stag
 
-go 1.14
+go 1.26.0
 
-require golang.org/x/tools v0.1.10
+require (
+	golang.org/x/tools v0.50.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	golang.org/x/mod v0.41.0 // indirect
+	golang.org/x/sync v0.23.0 // indirect
+)
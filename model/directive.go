@@ -0,0 +1,103 @@
+package model
+
+import (
+	"fmt"
+	"go/token"
+	"strings"
+)
+
+// DirectivePrefix starts every comment directive stag reads from struct and field docs
+const DirectivePrefix = "//stag:"
+
+// Directives are the //stag: comments on a struct or field:
+//
+//	//stag:generate json,db     tags to generate a struct for, instead of those given on the cmd line
+//	//stag:skip                 leave out a struct or field, for every tag or only those listed
+//	//stag:name db=legacy_col   override the name a tag gives a field
+//	//stag:ident Foo            rename the generated Go identifier
+type Directives struct {
+	Generate []string
+	Skip     bool
+	SkipTags []string // tags the skip applies to; all when empty
+	Names    map[string]string
+	Ident    string
+}
+
+// Skips reports whether the directives leave out the struct or field for tag
+func (d Directives) Skips(tag string) bool {
+	if !d.Skip {
+		return false
+	}
+	if len(d.SkipTags) == 0 {
+		return true
+	}
+	return containsString(d.SkipTags, tag)
+}
+
+// Generates reports whether a struct is generated for tag, given the tags asked for on the cmd line
+func (d Directives) Generates(tag string, cmdTags []string) bool {
+	if d.Skips(tag) {
+		return false
+	}
+	if len(d.Generate) > 0 {
+		return containsString(d.Generate, tag)
+	}
+	return containsString(cmdTags, tag)
+}
+
+// Add parses a single comment line into the directives, ignoring comments which aren't directives
+func (d *Directives) Add(comment string) error {
+	if !strings.HasPrefix(comment, DirectivePrefix) {
+		return nil
+	}
+	directive := strings.TrimPrefix(comment, DirectivePrefix)
+	name, args := directive, ""
+	if sp := strings.IndexAny(directive, " \t"); sp >= 0 {
+		name, args = directive[:sp], strings.TrimSpace(directive[sp+1:])
+	}
+	switch name {
+	case "generate":
+		if args == "" {
+			return fmt.Errorf("%sgenerate needs the tags to generate", DirectivePrefix)
+		}
+		d.Generate = append(d.Generate, splitList(args)...)
+	case "skip":
+		d.Skip = true
+		d.SkipTags = append(d.SkipTags, splitList(args)...)
+	case "name":
+		for _, pair := range splitList(args) {
+			eq := strings.IndexRune(pair, '=')
+			if eq <= 0 || eq == len(pair)-1 {
+				return fmt.Errorf("%sname must be given as tag=name pairs, got %q", DirectivePrefix, pair)
+			}
+			if d.Names == nil {
+				d.Names = make(map[string]string)
+			}
+			d.Names[pair[:eq]] = pair[eq+1:]
+		}
+	case "ident":
+		if !token.IsIdentifier(args) {
+			return fmt.Errorf("%sident needs a valid Go identifier, got %q", DirectivePrefix, args)
+		}
+		d.Ident = args
+	default:
+		return fmt.Errorf("unknown directive %s%s", DirectivePrefix, name)
+	}
+	return nil
+}
+
+// splitList splits on commas and white space, dropping empty values
+func splitList(s string) []string {
+	return strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t'
+	})
+}
+
+func containsString(vals []string, s string) bool {
+	for _, v := range vals {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
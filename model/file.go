@@ -2,14 +2,23 @@ package model
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 )
 
 type File struct {
 	BasePath string // fully qualified path
 	PkgName  string
-	Structs  Structures
-	Imports  Imports
+	PkgPath  string // import path of the file's pkg
+	// BuildConstraint is the expression the file compiles under, from its //go:build line and name
+	BuildConstraint string
+	Structs         Structures
+	Imports         Imports
+}
+
+// IsTest reports whether the file is only compiled for tests
+func (f File) IsTest() bool {
+	return strings.HasSuffix(f.BasePath, "_test.go")
 }
 
 func (f File) FileName() string {
@@ -26,6 +35,20 @@ func (f File) BaseDir() string {
 
 type Files []*File
 
+// FindStructIn returns the struct by name from the files of the pkg at pkgPath
+func (fs Files) FindStructIn(pkgPath, name string) *Structure {
+	for _, f := range fs {
+		if f.PkgPath != pkgPath {
+			continue
+		}
+		if s := f.Structs.ByName(name); s != nil {
+			return s
+		}
+	}
+
+	return nil
+}
+
 func (fs Files) FindStruct(name string) *Structure {
 	for _, f := range fs {
 		if s := f.Structs.ByName(name); s != nil {
@@ -53,28 +76,264 @@ func (fs Files) EmbeddedImportPkgNames() []string {
 	return ret
 }
 
-func (fs Files) JoinEmbeds() {
+// JoinEmbeds promotes the fields of embedded structs into each struct, per tag, the way
+// encoding/json does: the shallowest field for a name wins, a tagged field breaks a tie at
+// the same depth, and names still ambiguous after that are dropped. An embed given a name by
+// a tag is a regular field for that tag rather than being flattened, as is an untagged one for
+// families having an inline option, i.e. yaml or bson, unless it's given. built are the structs not
+// parsed from the files, i.e. those of imported pkgs reached as embeds or struct-typed fields.
+func (fs Files) JoinEmbeds(built Structures) {
+	strucs := Structures{}
 	for _, f := range fs {
-		for _, s := range f.Structs {
-			for _, impEmb := range s.ImportEmbeds {
-				for tag, tagFields := range impEmb.Struct.FieldTagNames {
-					for _, tagField := range tagFields {
-						s.AddFieldTagName(tag, tagField.FieldName, tagField.TagName)
-					}
-				}
+		strucs = append(strucs, f.Structs...)
+	}
+	strucs = append(strucs, built...)
+	joined := make(map[*Structure]map[string]FieldTagNames)
+	for _, s := range strucs {
+		joined[s] = make(map[string]FieldTagNames)
+		for _, tag := range fs.embedTags(s, map[*Structure]bool{}) {
+			joined[s][tag] = dominantFields(fs.collectFields(s, tag, "", nil, nil, map[*Structure]bool{}))
+		}
+	}
+	// assigned once all are computed, so every struct is joined from the originals of its embeds
+	for s, fieldTagNames := range joined {
+		for tag, fields := range fieldTagNames {
+			if len(fields) == 0 {
+				delete(s.FieldTagNames, tag)
+				continue
 			}
-			for _, embName := range s.EmbedNames {
-				embStruct := fs.FindStruct(embName)
-				if embStruct == nil {
-					fmt.Printf("Could not find embed struct def for %s\n", embName)
-					continue
-				}
-				for tag, tagFields := range embStruct.FieldTagNames {
-					for _, tagField := range tagFields {
-						s.AddFieldTagName(tag, tagField.FieldName, tagField.TagName)
-					}
+			if s.FieldTagNames == nil {
+				s.FieldTagNames = make(map[string]FieldTagNames)
+			}
+			s.FieldTagNames[tag] = fields
+		}
+	}
+}
+
+// embeddedStructs returns the structs embedded into s which could be found, by the name they're embedded under
+func (fs Files) embeddedStructs(s *Structure) ([]string, []*Structure) {
+	names, strucs := []string{}, []*Structure{}
+	for _, impEmb := range s.ImportEmbeds {
+		if impEmb.Struct != nil {
+			names = append(names, impEmb.StructName)
+			strucs = append(strucs, impEmb.Struct)
+		}
+	}
+	for _, embName := range s.EmbedNames {
+		embStruct := fs.FindStructIn(s.PkgPath, embName)
+		if embStruct == nil {
+			fmt.Printf("Could not find embed struct def for %s\n", embName)
+			continue
+		}
+		names = append(names, embName)
+		strucs = append(strucs, embStruct)
+	}
+	return names, strucs
+}
+
+// embedTags returns every tag used by s or anything embedded in it
+func (fs Files) embedTags(s *Structure, visited map[*Structure]bool) []string {
+	if visited[s] {
+		return nil
+	}
+	visited[s] = true
+	tags := []string{}
+	for tag := range s.FieldTagNames {
+		tags = append(tags, tag)
+	}
+	_, strucs := fs.embeddedStructs(s)
+	for _, emb := range strucs {
+		tags = append(tags, fs.embedTags(emb, visited)...)
+	}
+	return tags
+}
+
+type depthField struct {
+	FieldTagName
+	index []int // index sequence of the field from the outermost struct, like reflect.StructField.Index
+}
+
+func (df depthField) depth() int {
+	return len(df.index) - 1
+}
+
+// collectFields gathers the fields s has for tag, along with those promoted from its embeds and inlined
+// struct fields, noting their index sequence. prefix is prepended to every name, for inlined fields having
+// one, and via holds the Go names of the embedded or inlined fields s is reached through.
+func (fs Files) collectFields(s *Structure, tag, prefix string, index []int, via []string, visited map[*Structure]bool) []depthField {
+	if visited[s] {
+		return nil
+	}
+	visited[s] = true
+	defer delete(visited, s)
+
+	fields := s.FieldsFor(tag)
+	collected := []depthField{}
+	for _, ftn := range fields {
+		// untagged embeds are flattened below, skipped ones are left out entirely
+		if ftn.IsSkipped() || (ftn.Embedded && !ftn.Tagged) {
+			continue
+		}
+		if inline, inlinePrefix := ftn.Inline(tag); inline && ftn.Nested != nil {
+			inlined := fs.collectFields(ftn.Nested, tag, prefix+inlinePrefix, appendIndex(index, ftn.Index), appendName(via, ftn.FieldName), visited)
+			// a named field's fields aren't promoted in Go, so they're only reached through it, i.e. Author.Name
+			for idx := range inlined {
+				inlined[idx].Qualified = true
+			}
+			collected = append(collected, inlined...)
+			continue
+		}
+		ftn.TagName = prefix + ftn.TagName
+		ftn.Promoted = via
+		collected = append(collected, depthField{FieldTagName: ftn, index: appendIndex(index, ftn.Index)})
+	}
+
+	names, strucs := fs.embeddedStructs(s)
+	for idx, emb := range strucs {
+		embIndex := appendIndex(index, s.EmbedIndexes[names[idx]])
+		embField := fieldNamed(fields, names[idx])
+		if embField != nil && embField.Embedded && (embField.Tagged || embField.IsSkipped()) {
+			continue
+		}
+		if flattensEmbed(tag, embField) {
+			collected = append(collected, fs.collectFields(emb, tag, prefix, embIndex, appendName(via, names[idx]), visited)...)
+			continue
+		}
+		// a family having an inline option only flattens embeds carrying it, others are regular fields named after their type
+		ftn := FieldTagName{FieldName: names[idx], Embedded: true}
+		switch {
+		case embField != nil:
+			ftn = *embField
+		case !s.PolicyFor(tag).Untagged:
+			continue
+		default:
+			if declared := fieldNamed(s.Embeds, names[idx]); declared != nil {
+				ftn = *declared
+			}
+			if ftn.Directives.Skips(tag) {
+				continue
+			}
+		}
+		ftn.TagName = prefix + s.PolicyFor(tag).Naming.Apply(names[idx])
+		ftn.Nested = emb
+		ftn.Promoted = via
+		collected = append(collected, depthField{FieldTagName: ftn.withDirectives(tag), index: embIndex})
+	}
+	return collected
+}
+
+// flattensEmbed reports whether tag promotes the fields of an embedded struct whose field for tag, if any, is embField.
+// Like encoding/json, most families do; those having an inline option, like yaml.v3, only do when it's given.
+func flattensEmbed(tag string, embField *FieldTagName) bool {
+	if _, inlines := InlineOptions[tag]; !inlines {
+		return true
+	}
+	if embField == nil {
+		return false
+	}
+	inline, _ := embField.Inline(tag)
+	return inline
+}
+
+// appendIndex returns a copy of index with idx appended, so sibling fields never share a backing array
+func appendIndex(index []int, idx int) []int {
+	return append(append(make([]int, 0, len(index)+1), index...), idx)
+}
+
+func appendName(names []string, name string) []string {
+	return append(append(make([]string, 0, len(names)+1), names...), name)
+}
+
+func fieldNamed(ftns FieldTagNames, fieldName string) *FieldTagName {
+	for idx := range ftns {
+		if ftns[idx].FieldName == fieldName {
+			return &ftns[idx]
+		}
+	}
+	return nil
+}
+
+// dominantFields picks the field that wins each tag name, ordered by index sequence the way encoding/json orders
+// them. Fields whose Go name is shadowed by a shallower one, or is ambiguous, are qualified by the fields they're
+// promoted through, so each has an identifier of its own.
+func dominantFields(collected []depthField) FieldTagNames {
+	order := []string{}
+	byName := make(map[string][]depthField)
+	for _, df := range collected {
+		if _, exists := byName[df.TagName]; !exists {
+			order = append(order, df.TagName)
+		}
+		byName[df.TagName] = append(byName[df.TagName], df)
+	}
+
+	dominant := []depthField{}
+	for _, name := range order {
+		candidates := byName[name]
+		minDepth := candidates[0].depth()
+		for _, c := range candidates {
+			if c.depth() < minDepth {
+				minDepth = c.depth()
+			}
+		}
+		shallowest, tagged := []depthField{}, []depthField{}
+		for _, c := range candidates {
+			if c.depth() == minDepth {
+				shallowest = append(shallowest, c)
+				if c.Tagged {
+					tagged = append(tagged, c)
 				}
 			}
 		}
+		switch {
+		case len(shallowest) == 1:
+			dominant = append(dominant, shallowest[0])
+		case len(tagged) == 1:
+			dominant = append(dominant, tagged[0])
+		}
+		// otherwise the name is ambiguous, and dropped
+	}
+	sort.SliceStable(dominant, func(i, j int) bool {
+		return lessIndex(dominant[i].index, dominant[j].index)
+	})
+
+	// the Go name is left to the field a selector of it resolves to, i.e. the shallowest one when there's only one
+	byGoName := make(map[string][]int)
+	for idx, df := range dominant {
+		byGoName[df.FieldName] = append(byGoName[df.FieldName], idx)
+	}
+	for _, idxs := range byGoName {
+		if len(idxs) == 1 {
+			continue
+		}
+		shallowest, unique := idxs[0], true
+		for _, idx := range idxs[1:] {
+			switch {
+			case len(dominant[idx].Promoted) < len(dominant[shallowest].Promoted):
+				shallowest, unique = idx, true
+			case len(dominant[idx].Promoted) == len(dominant[shallowest].Promoted):
+				unique = false
+			}
+		}
+		for _, idx := range idxs {
+			if idx != shallowest || !unique {
+				dominant[idx].Qualified = len(dominant[idx].Promoted) > 0
+			}
+		}
+	}
+
+	fields := make(FieldTagNames, len(dominant))
+	for idx, df := range dominant {
+		fields[idx] = df.FieldTagName
+	}
+	return fields
+}
+
+// lessIndex orders index sequences field by field, a field's own sequence coming before those of its fields
+func lessIndex(a, b []int) bool {
+	for idx := 0; idx < len(a) && idx < len(b); idx++ {
+		if a[idx] != b[idx] {
+			return a[idx] < b[idx]
+		}
 	}
+	return len(a) < len(b)
 }
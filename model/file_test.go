@@ -0,0 +1,221 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+const testPkg = "example.com/pkg"
+
+// testStruct is a struct of testPkg; fields are declared in order, and named ones embed the struct they're named after
+func testStruct(name string, embeds []string, fields ...FieldTagName) *Structure {
+	s := &Structure{Name: name, PkgPath: testPkg}
+	idx := 0
+	for _, emb := range embeds {
+		idx++
+		s.EmbedNames = append(s.EmbedNames, emb)
+		s.AddEmbedIndex(emb, idx)
+	}
+	for _, f := range fields {
+		idx++
+		f.Index = idx
+		if f.Embedded {
+			s.AddEmbedIndex(f.FieldName, idx)
+			s.EmbedNames = append(s.EmbedNames, f.FieldName)
+		}
+		if f.TagName == "" {
+			s.Fields = append(s.Fields, FieldTagName{FieldName: f.FieldName, TagName: f.FieldName, Index: idx})
+			continue
+		}
+		s.AddFieldTag("json", f)
+	}
+	return s
+}
+
+func tagged(fieldName, tagName string) FieldTagName {
+	return FieldTagName{FieldName: fieldName, TagName: tagName, Tagged: true}
+}
+
+func untagged(fieldName string) FieldTagName {
+	return FieldTagName{FieldName: fieldName}
+}
+
+func joined(t *testing.T, target string, strucs ...*Structure) FieldTagNames {
+	t.Helper()
+	files := Files{{PkgPath: testPkg, Structs: strucs}}
+	files.JoinEmbeds(nil)
+	return files.FindStruct(target).FieldTagNames["json"]
+}
+
+func TestJoinEmbedsDominance(t *testing.T) {
+	type field struct{ Ident, GoName, TagName string }
+	tests := []struct {
+		name   string
+		strucs []*Structure
+		want   []field
+	}{
+		{
+			name: "shallower field wins",
+			strucs: []*Structure{
+				testStruct("Base", nil, tagged("Name", "name"), tagged("ID", "id")),
+				testStruct("Outer", []string{"Base"}, tagged("Title", "name")),
+			},
+			want: []field{{"ID", "ID", "id"}, {"Title", "Title", "name"}},
+		},
+		{
+			name: "tagged field breaks a tie at the same depth",
+			strucs: []*Structure{
+				testStruct("L", nil, tagged("X", "X")),
+				testStruct("R", nil, untagged("X")),
+				testStruct("Both", []string{"L", "R"}),
+			},
+			want: []field{{"X", "X", "X"}},
+		},
+		{
+			name: "ambiguous names are dropped",
+			strucs: []*Structure{
+				testStruct("L", nil, tagged("X", "x"), tagged("Y", "y")),
+				testStruct("R", nil, tagged("X", "x")),
+				testStruct("Both", []string{"L", "R"}),
+			},
+			want: []field{{"Y", "Y", "y"}},
+		},
+		{
+			name: "deeper ambiguity is shadowed by a shallower field",
+			strucs: []*Structure{
+				testStruct("L", nil, tagged("X", "x")),
+				testStruct("R", nil, tagged("X", "x")),
+				testStruct("Both", []string{"L", "R"}, tagged("Own", "x")),
+			},
+			want: []field{{"Own", "Own", "x"}},
+		},
+		{
+			name: "tagged embed is a named field",
+			strucs: []*Structure{
+				testStruct("User", nil, tagged("Name", "name")),
+				testStruct("Wrap", nil, FieldTagName{FieldName: "User", TagName: "user", Tagged: true, Embedded: true}),
+			},
+			want: []field{{"User", "User", "user"}},
+		},
+		{
+			name: "ordered by index sequence",
+			strucs: []*Structure{
+				testStruct("Page", nil, tagged("Items", "items"), tagged("Total", "total")),
+				testStruct("UserPage", []string{"Page"}, tagged("Cursor", "cursor")),
+			},
+			want: []field{{"Items", "Items", "items"}, {"Total", "Total", "total"}, {"Cursor", "Cursor", "cursor"}},
+		},
+		{
+			name: "embed declared after a field",
+			strucs: []*Structure{
+				testStruct("Page", nil, tagged("Items", "items")),
+				testStruct("UserPage", nil, tagged("Cursor", "cursor"), FieldTagName{FieldName: "Page", TagName: "Page", Embedded: true}),
+			},
+			want: []field{{"Cursor", "Cursor", "cursor"}, {"Items", "Items", "items"}},
+		},
+		{
+			name: "shadowed Go name is qualified",
+			strucs: []*Structure{
+				testStruct("Base", nil, tagged("Name", "base_name"), tagged("ID", "id")),
+				testStruct("Outer", []string{"Base"}, tagged("Name", "name")),
+			},
+			want: []field{{"Base_Name", "Base.Name", "base_name"}, {"ID", "ID", "id"}, {"Name", "Name", "name"}},
+		},
+		{
+			name: "ambiguous Go names are all qualified",
+			strucs: []*Structure{
+				testStruct("L", nil, tagged("X", "lx")),
+				testStruct("R", nil, tagged("X", "rx")),
+				testStruct("Both", []string{"L", "R"}),
+			},
+			want: []field{{"L_X", "L.X", "lx"}, {"R_X", "R.X", "rx"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []field{}
+			for _, f := range joined(t, tt.strucs[len(tt.strucs)-1].Name, tt.strucs...) {
+				got = append(got, field{f.Ident(), f.GoName(), f.TagName})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("joined fields = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinEmbedsInlinesEmbeds(t *testing.T) {
+	geo := &Structure{Name: "Geo", PkgPath: testPkg}
+	geo.AddFieldTag("bson", FieldTagName{FieldName: "Lat", TagName: "lat", Tagged: true, Index: 1})
+	address := &Structure{Name: "Address", PkgPath: testPkg, EmbedNames: []string{"Geo"}}
+	address.AddEmbedIndex("Geo", 1)
+	address.AddFieldTag("bson", FieldTagName{FieldName: "Geo", TagName: "Geo", Options: []string{"inline"}, Embedded: true, Index: 1})
+	address.AddFieldTag("bson", FieldTagName{FieldName: "Street", TagName: "street", Tagged: true, Index: 2})
+	person := &Structure{Name: "Person", PkgPath: testPkg}
+	person.AddFieldTag("bson", FieldTagName{FieldName: "Name", TagName: "name", Tagged: true, Index: 1})
+	person.AddFieldTag("bson", FieldTagName{FieldName: "Addr", TagName: "Addr", Options: []string{"inline"}, Index: 2, Nested: address})
+
+	files := Files{{PkgPath: testPkg, Structs: Structures{geo, address, person}}}
+	files.JoinEmbeds(nil)
+	got := []string{}
+	for _, f := range person.FieldTagNames["bson"] {
+		got = append(got, f.Ident()+":"+f.GoName()+"="+f.TagName)
+	}
+	// fields of the named Addr are only reached through it in Go
+	if want := []string{"Name:Name=name", "Addr_Geo_Lat:Addr.Geo.Lat=lat", "Addr_Street:Addr.Street=street"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Person bson fields = %v, want %v", got, want)
+	}
+}
+
+type YAMLBase struct {
+	ID int `yaml:"id"`
+}
+
+type YAMLAddr struct {
+	Street string `yaml:"street"`
+}
+
+type YAMLDoc struct {
+	YAMLBase
+	YAMLAddr `yaml:",inline"`
+	Name     string `yaml:"name"`
+}
+
+// a family having an inline option only flattens embeds carrying it, the way yaml.v3 marshals them
+func TestJoinEmbedsMatchesYAML(t *testing.T) {
+	base := &Structure{Name: "YAMLBase", PkgPath: testPkg}
+	base.AddFieldTag("yaml", FieldTagName{FieldName: "ID", TagName: "id", Tagged: true, Index: 1})
+	addr := &Structure{Name: "YAMLAddr", PkgPath: testPkg}
+	addr.AddFieldTag("yaml", FieldTagName{FieldName: "Street", TagName: "street", Tagged: true, Index: 1})
+	doc := &Structure{Name: "YAMLDoc", PkgPath: testPkg, EmbedNames: []string{"YAMLBase", "YAMLAddr"}}
+	doc.AddEmbedIndex("YAMLBase", 1)
+	doc.AddEmbedIndex("YAMLAddr", 2)
+	doc.AddFieldTag("yaml", FieldTagName{FieldName: "YAMLAddr", TagName: "YAMLAddr", Options: []string{"inline"}, Embedded: true, Index: 2})
+	doc.AddFieldTag("yaml", FieldTagName{FieldName: "Name", TagName: "name", Tagged: true, Index: 3})
+
+	files := Files{{PkgPath: testPkg, Structs: Structures{base, addr, doc}}}
+	files.JoinEmbeds(nil)
+
+	out, err := yaml.Marshal(YAMLDoc{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var node yaml.Node
+	if err := yaml.Unmarshal(out, &node); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{}
+	for idx, key := range node.Content[0].Content {
+		if idx%2 == 0 {
+			want = append(want, key.Value)
+		}
+	}
+	if got := doc.FieldTagNames["yaml"].TagNames(); !reflect.DeepEqual(got, want) {
+		t.Errorf("YAMLDoc yaml names = %v, yaml.v3 marshals %v", got, want)
+	}
+	if nested := doc.FieldTagNames["yaml"].ByTagName("yamlbase"); nested == nil || nested.Nested != base {
+		t.Errorf("yamlbase field = %v, want it nesting YAMLBase", nested)
+	}
+}
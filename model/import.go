@@ -1,5 +1,7 @@
 package model
 
+import "go/types"
+
 // Import is a singular import on a File
 type Import struct {
 	PkgPath string // full.domain/path/to/pkg
@@ -17,10 +19,11 @@ func (i Imports) ByAlias(alias string) *Import {
 	return nil
 }
 
-// ImportEmbed is an imported type that is embedded into a struct
+// ImportEmbed is an imported type that is embedded into a struct, or an instantiated generic one of any pkg
 type ImportEmbed struct {
 	PkgName               string // short name used when referencing the imported pkg
 	FullyQualifiedPkgName string // full pkg path
 	StructName            string
+	Type                  types.Type // as type-checked, along with any type arguments, i.e. Page[User]
 	Struct                *Structure
 }
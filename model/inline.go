@@ -0,0 +1,37 @@
+package model
+
+import "strings"
+
+// InlineOptions maps a tag family to the option which inlines a named struct field's fields into
+// its parent, the same as embedding would. Families can be added to, i.e. from the cmd flags.
+var InlineOptions = map[string]string{
+	"bson":         "inline",
+	"yaml":         "inline",
+	"mapstructure": "squash",
+}
+
+// Inline reports whether the field's struct fields are inlined into its parent for tag, along
+// with the prefix those fields' names take, for families supporting one.
+func (ftn FieldTagName) Inline(tag string) (bool, string) {
+	if tag == "gorm" {
+		// gorm uses semicolon-separated key:value settings, i.e. embedded;embeddedPrefix:author_
+		inline, prefix := false, ""
+		for _, setting := range strings.Split(ftn.Value, ";") {
+			key, val := setting, ""
+			if colon := strings.IndexRune(setting, ':'); colon >= 0 {
+				key, val = setting[:colon], setting[colon+1:]
+			}
+			switch strings.ToLower(strings.TrimSpace(key)) {
+			case "embedded":
+				inline = true
+			case "embeddedprefix":
+				prefix = strings.TrimSpace(val)
+			}
+		}
+		return inline, prefix
+	}
+	if opt, exists := InlineOptions[tag]; exists {
+		return ftn.HasOption(tag, opt), ""
+	}
+	return false, ""
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Naming is a strategy for deriving a tag name from a Go field name
+type Naming string
+
+const (
+	NamingGo     Naming = "go"     // FirstName
+	NamingLower  Naming = "lower"  // firstname
+	NamingSnake  Naming = "snake"  // first_name
+	NamingCamel  Naming = "camel"  // firstName
+	NamingKebab  Naming = "kebab"  // first-name
+	NamingPascal Naming = "pascal" // FirstName, from any of the others
+)
+
+// Initialisms are kept in upper case by pascal naming, i.e. user_id to UserID
+var Initialisms = map[string]bool{
+	"API": true, "BSON": true, "CSV": true, "DB": true, "DNS": true, "HCL": true, "HTML": true, "HTTP": true,
+	"HTTPS": true, "ID": true, "IP": true, "JSON": true, "SQL": true, "TOML": true, "UI": true, "URI": true,
+	"URL": true, "UTF8": true, "UUID": true, "XML": true, "YAML": true,
+}
+
+func (n Naming) Valid() bool {
+	switch n {
+	case NamingGo, NamingLower, NamingSnake, NamingCamel, NamingKebab, NamingPascal:
+		return true
+	default:
+		return false
+	}
+}
+
+// Apply derives the name for goName; an unknown strategy leaves it as is
+func (n Naming) Apply(goName string) string {
+	switch n {
+	case NamingLower:
+		return strings.ToLower(goName)
+	case NamingSnake:
+		return strings.ToLower(strings.Join(Words(goName), "_"))
+	case NamingKebab:
+		return strings.ToLower(strings.Join(Words(goName), "-"))
+	case NamingCamel:
+		words := Words(goName)
+		for idx := range words {
+			words[idx] = strings.ToLower(words[idx])
+			if idx > 0 {
+				words[idx] = strings.ToUpper(words[idx][:1]) + words[idx][1:]
+			}
+		}
+		return strings.Join(words, "")
+	case NamingPascal:
+		words := Words(goName)
+		for idx, word := range words {
+			if upper := strings.ToUpper(word); Initialisms[upper] {
+				words[idx] = upper
+			} else {
+				words[idx] = strings.ToUpper(word[:1]) + strings.ToLower(word[1:])
+			}
+		}
+		return strings.Join(words, "")
+	default:
+		return goName
+	}
+}
+
+// Words splits an identifier into its words, keeping initialisms together,
+// i.e. JSONBlankName to JSON, Blank, Name and user_id to user, id
+func Words(name string) []string {
+	words := []string{}
+	runes := []rune(name)
+	start := 0
+	for idx := 0; idx < len(runes); idx++ {
+		r := runes[idx]
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if idx > start {
+				words = append(words, string(runes[start:idx]))
+			}
+			start = idx + 1
+			continue
+		}
+		if idx == start || !unicode.IsUpper(r) {
+			continue
+		}
+		prev := runes[idx-1]
+		nextIsLower := idx+1 < len(runes) && unicode.IsLower(runes[idx+1])
+		// a new word starts at an upper after a lower or digit, or at the last upper of an initialism followed by a lower
+		if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+			words = append(words, string(runes[start:idx]))
+			start = idx
+		}
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWords(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{name: "", want: []string{}},
+		{name: "Name", want: []string{"Name"}},
+		{name: "FirstName", want: []string{"First", "Name"}},
+		{name: "firstName", want: []string{"first", "Name"}},
+		{name: "ID", want: []string{"ID"}},
+		{name: "UserID", want: []string{"User", "ID"}},
+		{name: "JSONBlankName", want: []string{"JSON", "Blank", "Name"}},
+		{name: "HTTPServer2", want: []string{"HTTP", "Server2"}},
+		{name: "Utf8Name", want: []string{"Utf8", "Name"}},
+		{name: "user_id", want: []string{"user", "id"}},
+		{name: "first-name", want: []string{"first", "name"}},
+		{name: "__Name__", want: []string{"Name"}},
+	}
+	for _, tt := range tests {
+		if got := Words(tt.name); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Words(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestNamingApply(t *testing.T) {
+	tests := []struct {
+		naming Naming
+		goName string
+		want   string
+	}{
+		{naming: NamingGo, goName: "UserID", want: "UserID"},
+		{naming: NamingLower, goName: "UserID", want: "userid"},
+		{naming: NamingSnake, goName: "UserID", want: "user_id"},
+		{naming: NamingSnake, goName: "JSONBlankName", want: "json_blank_name"},
+		{naming: NamingCamel, goName: "UserID", want: "userId"},
+		{naming: NamingCamel, goName: "JSONBlankName", want: "jsonBlankName"},
+		{naming: NamingKebab, goName: "FirstName", want: "first-name"},
+		{naming: NamingPascal, goName: "user_id", want: "UserID"},
+		{naming: NamingPascal, goName: "db-col", want: "DBCol"},
+		{naming: NamingPascal, goName: "firstName", want: "FirstName"},
+		{naming: Naming("unknown"), goName: "FirstName", want: "FirstName"},
+	}
+	for _, tt := range tests {
+		if got := tt.naming.Apply(tt.goName); got != tt.want {
+			t.Errorf("Naming(%q).Apply(%q) = %q, want %q", tt.naming, tt.goName, got, tt.want)
+		}
+	}
+}
+
+func TestNamingValid(t *testing.T) {
+	for _, n := range []Naming{NamingGo, NamingLower, NamingSnake, NamingCamel, NamingKebab, NamingPascal} {
+		if !n.Valid() {
+			t.Errorf("Naming(%q).Valid() = false", n)
+		}
+	}
+	if Naming("screaming").Valid() {
+		t.Error(`Naming("screaming").Valid() = true`)
+	}
+}
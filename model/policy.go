@@ -0,0 +1,83 @@
+package model
+
+import (
+	"go/token"
+	"sort"
+)
+
+// TagPolicy is how a tag family treats the fields it has no name for
+type TagPolicy struct {
+	Untagged   bool   // exported fields without the tag are included
+	Unexported bool   // unexported fields are included, even when tagged
+	Naming     Naming // names untagged fields, and tagged ones giving no name
+}
+
+// DefaultTagPolicy applies to tag families without a policy of their own: only tagged fields, named by their Go name
+var DefaultTagPolicy = TagPolicy{Untagged: false, Unexported: true, Naming: NamingGo}
+
+// TagPolicies hold the built-in policies of common encoding libraries, and can be added to, i.e. from the cmd flags
+var TagPolicies = map[string]TagPolicy{
+	"json":         {Untagged: true, Unexported: false, Naming: NamingGo},
+	"xml":          {Untagged: true, Unexported: false, Naming: NamingGo},
+	"toml":         {Untagged: true, Unexported: false, Naming: NamingGo},
+	"mapstructure": {Untagged: true, Unexported: false, Naming: NamingGo},
+	"yaml":         {Untagged: true, Unexported: false, Naming: NamingLower},
+	"bson":         {Untagged: true, Unexported: false, Naming: NamingLower},
+	"db":           {Untagged: true, Unexported: false, Naming: NamingLower},
+	"gorm":         {Untagged: true, Unexported: false, Naming: NamingSnake},
+}
+
+// PolicyFor returns the policy of a tag family, as it applies to s
+func (s *Structure) PolicyFor(tag string) TagPolicy {
+	if policy, exists := s.Policies[tag]; exists {
+		return policy
+	}
+	return PolicyFor(tag)
+}
+
+func PolicyFor(tag string) TagPolicy {
+	if policy, exists := TagPolicies[tag]; exists {
+		return policy
+	}
+	return DefaultTagPolicy
+}
+
+// FieldsFor returns the fields of s for tag as its family's policy sees them: untagged fields
+// added or unexported ones removed, and names the tag didn't give derived from the Go name.
+func (s *Structure) FieldsFor(tag string) FieldTagNames {
+	policy := s.PolicyFor(tag)
+	tagged := s.FieldTagNames[tag]
+	fields := FieldTagNames{}
+	present := make(map[string]bool)
+	for _, ftn := range tagged {
+		present[ftn.FieldName] = true
+		if ftn.Directives.Skips(tag) || (!ftn.Embedded && !policy.Unexported && !token.IsExported(ftn.FieldName)) {
+			continue
+		}
+		if !ftn.Tagged && !ftn.Embedded && !ftn.IsSkipped() {
+			ftn.TagName = policy.Naming.Apply(ftn.FieldName)
+		}
+		fields = append(fields, ftn.withDirectives(tag))
+	}
+	if policy.Untagged {
+		for _, ftn := range s.Fields {
+			if present[ftn.FieldName] || !token.IsExported(ftn.FieldName) || ftn.Directives.Skips(tag) {
+				continue
+			}
+			ftn.TagName = policy.Naming.Apply(ftn.FieldName)
+			fields = append(fields, ftn.withDirectives(tag))
+		}
+	}
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Index < fields[j].Index
+	})
+	return fields
+}
+
+// withDirectives applies a //stag:name override for tag
+func (ftn FieldTagName) withDirectives(tag string) FieldTagName {
+	if name, exists := ftn.Directives.Names[tag]; exists {
+		ftn.TagName, ftn.Tagged = name, true
+	}
+	return ftn
+}
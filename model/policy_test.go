@@ -0,0 +1,117 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// policyStruct has an untagged field, an unexported tagged one, and a tagged one without a name
+func policyStruct() *Structure {
+	s := &Structure{Name: "Acct", PkgPath: testPkg}
+	s.Fields = FieldTagNames{
+		{FieldName: "UserID", TagName: "UserID", Index: 1},
+		{FieldName: "secret", TagName: "secret", Index: 2},
+		{FieldName: "CreatedAt", TagName: "CreatedAt", Index: 3},
+		{FieldName: "Note", TagName: "Note", Index: 4},
+	}
+	for _, tag := range []string{"json", "db", "custom"} {
+		s.AddFieldTag(tag, FieldTagName{FieldName: "secret", TagName: "secret", Tagged: true, Index: 2})
+		s.AddFieldTag(tag, FieldTagName{FieldName: "CreatedAt", TagName: "CreatedAt", Options: []string{"omitempty"}, Index: 3})
+	}
+	return s
+}
+
+func TestFieldsForPolicy(t *testing.T) {
+	tests := []struct {
+		tag      string
+		policies map[string]TagPolicy
+		want     []string
+	}{
+		// json includes untagged exported fields under their Go name, and leaves out unexported ones
+		{tag: "json", want: []string{"UserID=UserID", "CreatedAt=CreatedAt", "Note=Note"}},
+		// db names those the tag gives no name in lower case
+		{tag: "db", want: []string{"UserID=userid", "CreatedAt=createdat", "Note=note"}},
+		// without a policy of its own, a family only has its tagged fields, unexported ones included
+		{tag: "custom", want: []string{"secret=secret", "CreatedAt=CreatedAt"}},
+		{
+			tag:      "db",
+			policies: map[string]TagPolicy{"db": {Untagged: false, Unexported: false, Naming: NamingSnake}},
+			want:     []string{"CreatedAt=created_at"},
+		},
+		{
+			tag:      "custom",
+			policies: map[string]TagPolicy{"custom": {Untagged: true, Unexported: true, Naming: NamingCamel}},
+			want:     []string{"UserID=userId", "secret=secret", "CreatedAt=createdAt", "Note=note"},
+		},
+	}
+	for _, tt := range tests {
+		s := policyStruct()
+		s.Policies = tt.policies
+		got := []string{}
+		for _, f := range s.FieldsFor(tt.tag) {
+			got = append(got, f.FieldName+"="+f.TagName)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FieldsFor(%q) with %v = %v, want %v", tt.tag, tt.policies, got, tt.want)
+		}
+	}
+}
+
+func TestFieldsForDirectives(t *testing.T) {
+	s := policyStruct()
+	s.Fields[3].Directives = Directives{Skip: true, SkipTags: []string{"json"}}
+	s.Fields[0].Directives = Directives{Names: map[string]string{"json": "uid"}}
+	got := []string{}
+	for _, f := range s.FieldsFor("json") {
+		got = append(got, f.FieldName+"="+f.TagName)
+	}
+	if want := []string{"UserID=uid", "CreatedAt=CreatedAt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf(`FieldsFor("json") = %v, want %v`, got, want)
+	}
+}
+
+func TestDuplicates(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields FieldTagNames
+		want   []string
+	}{
+		{
+			name:   "tagged field wins over an untagged one",
+			fields: FieldTagNames{{FieldName: "Tagged", TagName: "Username", Tagged: true}, {FieldName: "Username", TagName: "Username"}},
+			want:   []string{},
+		},
+		{
+			name:   "tagged alike",
+			fields: FieldTagNames{{FieldName: "A", TagName: "name", Tagged: true}, {FieldName: "B", TagName: "name", Tagged: true}},
+			want:   []string{"B"},
+		},
+		{
+			name:   "untagged alike, i.e. named the same by lower naming",
+			fields: FieldTagNames{{FieldName: "ID", TagName: "id"}, {FieldName: "Id", TagName: "id"}},
+			want:   []string{"Id"},
+		},
+		{
+			name:   "skipped fields are left out",
+			fields: FieldTagNames{{FieldName: "A", TagName: "-", Tagged: true, Skipped: true}, {FieldName: "B", TagName: "-", Tagged: true, Skipped: true}},
+			want:   []string{},
+		},
+		{
+			name:   "a field named - by a value of -, is not skipped",
+			fields: FieldTagNames{{FieldName: "A", TagName: "-", Tagged: true, Value: "-,"}, {FieldName: "B", TagName: "-", Tagged: true, Value: "-,"}},
+			want:   []string{"B"},
+		},
+	}
+	for _, tt := range tests {
+		got := []string{}
+		for _, dupe := range tt.fields.Duplicates() {
+			got = append(got, dupe.FieldName)
+			if first := tt.fields.FirstLike(dupe); first == nil || first.FieldName == dupe.FieldName {
+				t.Errorf("%s: FirstLike(%s) = %v, want the field it duplicates", tt.name, dupe.FieldName, first)
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Duplicates() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,20 +1,55 @@
 package model
 
+import (
+	"go/token"
+	"go/types"
+	"strings"
+)
+
 type Structure struct {
 	Name          string
-	EmbedNames    []string      // used for pkg-local embeds
-	ImportEmbeds  []ImportEmbed // used for embeds from imported pkg
+	PkgPath       string         // import path of the pkg declaring the struct
+	Doc           string         // doc comment of the struct's declaration
+	TypeParams    []string       // names of the type parameters of a generic struct, i.e. T for Page[T any]
+	EmbedNames    []string       // used for pkg-local embeds
+	ImportEmbeds  []ImportEmbed  // used for embeds from imported pkg
+	EmbedIndexes  map[string]int // declaration index of every embed, by the name it's embedded under
+	Embeds        FieldTagNames  // every embedded struct as a field named after its type, for families not flattening it
 	FieldTagNames map[string]FieldTagNames
+	Fields        FieldTagNames        // every named field regardless of tags, named by their Go name
+	FromType      bool                 // a defined type or alias of another struct, so has no fields of its own in the source
+	Directives    Directives           // //stag: comments on the struct's declaration
+	Policies      map[string]TagPolicy // overrides of the tag policies for the struct's dir, i.e. from the project config
+}
+
+// Ident is the name of the struct in generated code
+func (s *Structure) Ident() string {
+	if s.Directives.Ident != "" {
+		return s.Directives.Ident
+	}
+	return s.Name
 }
 
 func (s *Structure) AddFieldTagName(tag, fieldName, tagName string) {
+	s.AddFieldTag(tag, FieldTagName{FieldName: fieldName, TagName: tagName})
+}
+
+func (s *Structure) AddFieldTag(tag string, ftn FieldTagName) {
 	if s.FieldTagNames == nil {
 		s.FieldTagNames = make(map[string]FieldTagNames)
 	}
 	if _, exists := s.FieldTagNames[tag]; !exists {
 		s.FieldTagNames[tag] = FieldTagNames{}
 	}
-	s.FieldTagNames[tag] = append(s.FieldTagNames[tag], FieldTagName{FieldName: fieldName, TagName: tagName})
+	s.FieldTagNames[tag] = append(s.FieldTagNames[tag], ftn)
+}
+
+// AddEmbedIndex records the declaration index of the embed named name
+func (s *Structure) AddEmbedIndex(name string, idx int) {
+	if s.EmbedIndexes == nil {
+		s.EmbedIndexes = make(map[string]int)
+	}
+	s.EmbedIndexes[name] = idx
 }
 
 type Structures []*Structure
@@ -63,19 +98,113 @@ func (s Structures) EmbeddedImportPkgNames() []string {
 type FieldTagName struct {
 	FieldName string
 	TagName   string
+	Options   []string // everything after the name in the tag value, i.e. omitempty
+	Value     string   // the tag value as written, for families not following the name,options convention
+	Position  token.Position
+	Index     int      // declaration order within the struct, starting at 1
+	Tagged    bool     // the tag gave the name explicitly, rather than defaulting to FieldName
+	Embedded  bool     // the field is an embedded struct, named after its type
+	Skipped   bool     // the tag value is exactly a skip marker, leaving the field out
+	Promoted  []string // Go names of the embedded or inlined fields it's reached through, outermost first
+	Qualified bool     // its Go name is shadowed by another field's, or it's inlined from a named field, so it's named along with Promoted
+
+	Type       FieldType
+	Doc        string     // doc and line comments of the field
+	Directives Directives // //stag: comments on the field
+
+	NestedPkgPath string     // pkg path of the field's type, when it is a (pointer to a) named struct
+	NestedName    string     // name of the field's struct type
+	NestedType    types.Type // the field's struct type as type-checked, along with any type arguments
+	Nested        *Structure // resolved struct of the field's type
 }
 
-func (ftn FieldTagName) IsSkipped() bool {
-	switch ftn.TagName {
-	case "-", "ignore":
-		return true
-	default:
-		return false
+// FieldType describes the Go type of a field
+type FieldType struct {
+	Expr     string // as written in Go, qualified by pkg name, i.e. *time.Time
+	Kind     Kind
+	Nullable bool   // the zero value is nil
+	PkgPath  string // pkg declaring the named type, beneath any pointer
+}
+
+// Kind is the broad category of a field's type, going by its underlying type
+type Kind string
+
+const (
+	KindBasic     Kind = "basic"
+	KindPointer   Kind = "pointer"
+	KindSlice     Kind = "slice"
+	KindArray     Kind = "array"
+	KindMap       Kind = "map"
+	KindStruct    Kind = "struct"
+	KindInterface Kind = "interface"
+	KindFunc      Kind = "func"
+	KindChan      Kind = "chan"
+	KindTypeParam Kind = "typeparam"
+)
+
+// OptionSep separates the key of a tag family's key/value options from the value, i.e. = for
+// validate:"min=1", or : for gorm:"type:uuid"
+func OptionSep(tag string) string {
+	if tag == "gorm" {
+		return ":"
+	}
+	return "="
+}
+
+// HasOption reports whether opt was given for tag, either bare or as the key of a key/value option
+func (ftn FieldTagName) HasOption(tag, opt string) bool {
+	for _, o := range ftn.Options {
+		if o == opt || strings.HasPrefix(o, opt+OptionSep(tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ident is the name of the field in generated code, i.e. Base_Name for a promoted Base.Name that is shadowed
+func (ftn FieldTagName) Ident() string {
+	if ftn.Directives.Ident != "" {
+		return ftn.Directives.Ident
 	}
+	if ftn.Qualified {
+		return strings.Join(append(append([]string{}, ftn.Promoted...), ftn.FieldName), "_")
+	}
+	return ftn.FieldName
+}
+
+// GoName is the Go name of the field, along with the fields it's promoted through when it's shadowed, i.e. Base.Name
+func (ftn FieldTagName) GoName() string {
+	if ftn.Qualified {
+		return strings.Join(append(append([]string{}, ftn.Promoted...), ftn.FieldName), ".")
+	}
+	return ftn.FieldName
+}
+
+// SkipMarkers are the tag values leaving a field out, and can be replaced, i.e. from the project config
+var SkipMarkers = []string{"-", "ignore"}
+
+// IsSkipMarker reports whether a whole tag value leaves its field out. Only the exact value does,
+// as encoding/json has it: json:"-," names the field "-".
+func IsSkipMarker(value string) bool {
+	return containsString(SkipMarkers, value)
+}
+
+func (ftn FieldTagName) IsSkipped() bool {
+	return ftn.Skipped
 }
 
 type FieldTagNames []FieldTagName
 
+// HaveOptions reports whether any non-skipped field has tag options
+func (ftns FieldTagNames) HaveOptions() bool {
+	for _, ftn := range ftns {
+		if !ftn.IsSkipped() && len(ftn.Options) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (ftns FieldTagNames) AllSkipped() bool {
 	for _, ftn := range ftns {
 		if !ftn.IsSkipped() {
@@ -85,6 +214,45 @@ func (ftns FieldTagNames) AllSkipped() bool {
 	return true
 }
 
+// Duplicates returns every non-skipped field whose tag name was already used by an earlier field tagged alike.
+// A tagged field and an untagged one sharing a name aren't duplicates, as the tagged one wins, i.e. for encoding/json.
+func (ftns FieldTagNames) Duplicates() FieldTagNames {
+	dupes := FieldTagNames{}
+	seen := make(map[string]map[bool]bool)
+	for _, ftn := range ftns {
+		if ftn.IsSkipped() {
+			continue
+		}
+		if seen[ftn.TagName][ftn.Tagged] {
+			dupes = append(dupes, ftn)
+		}
+		if seen[ftn.TagName] == nil {
+			seen[ftn.TagName] = make(map[bool]bool)
+		}
+		seen[ftn.TagName][ftn.Tagged] = true
+	}
+	return dupes
+}
+
+// FirstLike returns the first field having the tag name of ftn and tagged alike
+func (ftns FieldTagNames) FirstLike(ftn FieldTagName) *FieldTagName {
+	for idx := range ftns {
+		if ftns[idx].TagName == ftn.TagName && ftns[idx].Tagged == ftn.Tagged {
+			return &ftns[idx]
+		}
+	}
+	return nil
+}
+
+func (ftns FieldTagNames) ByTagName(tagName string) *FieldTagName {
+	for idx := range ftns {
+		if ftns[idx].TagName == tagName {
+			return &ftns[idx]
+		}
+	}
+	return nil
+}
+
 func (ftns FieldTagNames) TagNames() []string {
 	t := []string{}
 	for _, tn := range ftns {
@@ -0,0 +1,116 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Tag is a single key:"value" pair out of a struct field's tag
+type Tag struct {
+	Key   string
+	Value string
+}
+
+type Tags []Tag
+
+// Lookup returns the value of the first pair having key, the same way reflect.StructTag.Lookup does
+func (t Tags) Lookup(key string) (string, bool) {
+	for _, tag := range t {
+		if tag.Key == key {
+			return tag.Value, true
+		}
+	}
+	return "", false
+}
+
+// Keys returns the distinct keys in the order they first appear
+func (t Tags) Keys() []string {
+	keys := []string{}
+	seen := make(map[string]bool)
+	for _, tag := range t {
+		if !seen[tag.Key] {
+			seen[tag.Key] = true
+			keys = append(keys, tag.Key)
+		}
+	}
+	return keys
+}
+
+// ParseTags lexes an unquoted struct tag following the reflect.StructTag conventions:
+// a key of non-control, non-space chars other than quote and colon, a colon, then a
+// Go-quoted string value. Pairs may be separated by any amount of white space.
+// Unlike reflect, anything malformed is reported instead of silently ignored.
+func ParseTags(tag string) (Tags, error) {
+	tags := Tags{}
+	for {
+		// skip leading space
+		i := 0
+		for i < len(tag) && unicode.IsSpace(rune(tag[i])) {
+			i++
+		}
+		tag = tag[i:]
+		if tag == "" {
+			return tags, nil
+		}
+
+		i = 0
+		for i < len(tag) && tag[i] > ' ' && tag[i] != ':' && tag[i] != '"' && tag[i] != 0x7f {
+			i++
+		}
+		if i == 0 {
+			return nil, fmt.Errorf("bad syntax for struct tag key at %q", tag)
+		}
+		if i >= len(tag) || tag[i] != ':' {
+			return nil, fmt.Errorf("struct tag key %q is missing a colon", tag[:i])
+		}
+		if i+1 >= len(tag) || tag[i+1] != '"' {
+			return nil, fmt.Errorf("struct tag value for key %q is not quoted", tag[:i])
+		}
+		key := tag[:i]
+		tag = tag[i+1:]
+
+		// scan quoted string to find value
+		i = 1
+		for i < len(tag) && tag[i] != '"' {
+			if tag[i] == '\\' {
+				i++
+			}
+			i++
+		}
+		if i >= len(tag) {
+			return nil, fmt.Errorf("struct tag value for key %q is missing its closing quote", key)
+		}
+		value, err := strconv.Unquote(tag[:i+1])
+		if err != nil {
+			return nil, fmt.Errorf("struct tag value for key %q is not a valid quoted string: %s", key, tag[:i+1])
+		}
+		tag = tag[i+1:]
+
+		tags = append(tags, Tag{Key: key, Value: value})
+	}
+}
+
+// SplitTagValue splits a tag value into the name it gives the field and its options. By convention
+// the name comes first, followed by comma-separated options; gorm instead uses semicolon-separated
+// settings, naming the field with its column setting.
+func SplitTagValue(key, value string) (string, []string) {
+	sep := ","
+	if key == "gorm" {
+		sep = ";"
+	}
+	name, options := "", []string{}
+	for idx, part := range strings.Split(value, sep) {
+		part = strings.TrimSpace(part)
+		switch {
+		case key == "gorm" && strings.HasPrefix(strings.ToLower(part), "column:"):
+			name = part[len("column:"):]
+		case idx == 0 && key != "gorm":
+			name = part
+		case part != "":
+			options = append(options, part)
+		}
+	}
+	return name, options
+}
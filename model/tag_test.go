@@ -0,0 +1,141 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tag  string
+		want Tags
+		err  string
+	}{
+		{name: "empty", tag: "", want: Tags{}},
+		{name: "only space", tag: " \t ", want: Tags{}},
+		{name: "single", tag: `json:"name"`, want: Tags{{Key: "json", Value: "name"}}},
+		{name: "several", tag: `json:"name,omitempty" db:"the_name"`, want: Tags{{Key: "json", Value: "name,omitempty"}, {Key: "db", Value: "the_name"}}},
+		{name: "value with spaces", tag: `validate:"min=1 max=5" json:"n"`, want: Tags{{Key: "validate", Value: "min=1 max=5"}, {Key: "json", Value: "n"}}},
+		{name: "tabs and repeated spaces", tag: "\tjson:\"a\"  \t db:\"b\" ", want: Tags{{Key: "json", Value: "a"}, {Key: "db", Value: "b"}}},
+		{name: "no space between pairs", tag: `json:"a"db:"b"`, want: Tags{{Key: "json", Value: "a"}, {Key: "db", Value: "b"}}},
+		{name: "gorm settings", tag: `gorm:"column:user_id;primaryKey"`, want: Tags{{Key: "gorm", Value: "column:user_id;primaryKey"}}},
+		{name: "escaped quote", tag: `json:"a\"b"`, want: Tags{{Key: "json", Value: `a"b`}}},
+		{name: "escaped backslash", tag: `json:"a\\" db:"b"`, want: Tags{{Key: "json", Value: `a\`}, {Key: "db", Value: "b"}}},
+		{name: "non-ascii value", tag: `json:"é"`, want: Tags{{Key: "json", Value: "é"}}},
+		{name: "empty value", tag: `json:""`, want: Tags{{Key: "json", Value: ""}}},
+		{name: "duplicate keys", tag: `json:"a" json:"b"`, want: Tags{{Key: "json", Value: "a"}, {Key: "json", Value: "b"}}},
+		{name: "key without colon", tag: `json`, err: `struct tag key "json" is missing a colon`},
+		{name: "key followed by space", tag: `json :"a"`, err: `struct tag key "json" is missing a colon`},
+		{name: "unquoted value", tag: `json:name`, err: `struct tag value for key "json" is not quoted`},
+		{name: "colon at end", tag: `json:`, err: `struct tag value for key "json" is not quoted`},
+		{name: "single quoted value", tag: `json:'a'`, err: `struct tag value for key "json" is not quoted`},
+		{name: "unterminated value", tag: `json:"name`, err: `struct tag value for key "json" is missing its closing quote`},
+		{name: "escaped closing quote", tag: `json:"name\"`, err: `struct tag value for key "json" is missing its closing quote`},
+		{name: "invalid escape", tag: `json:"\q"`, err: `struct tag value for key "json" is not a valid quoted string: "\q"`},
+		{name: "missing key", tag: `:"a"`, err: `bad syntax for struct tag key at ":\"a\""`},
+		{name: "quote in key", tag: `js"on:"a"`, err: `struct tag key "js" is missing a colon`},
+		{name: "bad second pair", tag: `json:"a" db`, err: `struct tag key "db" is missing a colon`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseTags(tt.tag)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("ParseTags(%q) error = %v, want %s", tt.tag, err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseTags(%q) unexpected error: %v", tt.tag, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseTags(%q) = %#v, want %#v", tt.tag, got, tt.want)
+			}
+		})
+	}
+}
+
+// ParseTags agrees with reflect.StructTag on every well-formed tag
+func TestParseTagsMatchesReflect(t *testing.T) {
+	tags := []string{
+		`json:"name,omitempty" db:"the_name"`,
+		`validate:"min=1 max=5"   json:"n"`,
+		`json:"a\"b" xml:"c\\"`,
+		`json:"first" json:"second"`,
+	}
+	for _, tag := range tags {
+		parsed, err := ParseTags(tag)
+		if err != nil {
+			t.Fatalf("ParseTags(%q) unexpected error: %v", tag, err)
+		}
+		for _, key := range parsed.Keys() {
+			got, _ := parsed.Lookup(key)
+			want, _ := reflect.StructTag(tag).Lookup(key)
+			if got != want {
+				t.Errorf("ParseTags(%q).Lookup(%q) = %q, reflect gives %q", tag, key, got, want)
+			}
+		}
+	}
+}
+
+func TestTagsKeys(t *testing.T) {
+	tags, err := ParseTags(`json:"a" db:"b" json:"c"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := tags.Keys(), []string{"json", "db"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if got, _ := tags.Lookup("json"); got != "a" {
+		t.Errorf(`Lookup("json") = %q, want the first value "a"`, got)
+	}
+	if _, ok := tags.Lookup("yaml"); ok {
+		t.Error(`Lookup("yaml") found a key that isn't there`)
+	}
+}
+
+func TestSplitTagValue(t *testing.T) {
+	tests := []struct {
+		key, value string
+		name       string
+		options    []string
+	}{
+		{key: "json", value: "name", name: "name", options: []string{}},
+		{key: "json", value: "name,omitempty,string", name: "name", options: []string{"omitempty", "string"}},
+		{key: "json", value: ",omitempty", name: "", options: []string{"omitempty"}},
+		{key: "json", value: "-", name: "-", options: []string{}},
+		{key: "json", value: "-,", name: "-", options: []string{}},
+		{key: "bson", value: ",inline", name: "", options: []string{"inline"}},
+		{key: "gorm", value: "column:user_id;primaryKey", name: "user_id", options: []string{"primaryKey"}},
+		{key: "gorm", value: "primaryKey; Column:id ", name: "id", options: []string{"primaryKey"}},
+		{key: "gorm", value: "embedded;embeddedPrefix:author_", name: "", options: []string{"embedded", "embeddedPrefix:author_"}},
+	}
+	for _, tt := range tests {
+		name, options := SplitTagValue(tt.key, tt.value)
+		if name != tt.name || !reflect.DeepEqual(options, tt.options) {
+			t.Errorf("SplitTagValue(%q, %q) = %q, %q, want %q, %q", tt.key, tt.value, name, options, tt.name, tt.options)
+		}
+	}
+}
+
+func TestHasOption(t *testing.T) {
+	tests := []struct {
+		tag, value, opt string
+		want            bool
+	}{
+		{tag: "json", value: "name,omitempty", opt: "omitempty", want: true},
+		{tag: "json", value: "name,omitempty", opt: "omit", want: false},
+		{tag: "validate", value: ",min=1,max=5", opt: "min", want: true},
+		{tag: "validate", value: ",min=1", opt: "min:1", want: false},
+		{tag: "gorm", value: "column:id;type:uuid;primaryKey", opt: "type", want: true},
+		{tag: "gorm", value: "column:id;type:uuid;primaryKey", opt: "primaryKey", want: true},
+		{tag: "gorm", value: "size=64", opt: "size", want: false},
+	}
+	for _, tt := range tests {
+		_, options := SplitTagValue(tt.tag, tt.value)
+		if got := (FieldTagName{Options: options}).HasOption(tt.tag, tt.opt); got != tt.want {
+			t.Errorf("HasOption(%q, %q) of %q = %t, want %t", tt.tag, tt.opt, tt.value, got, tt.want)
+		}
+	}
+}
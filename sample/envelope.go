@@ -0,0 +1,20 @@
+package sample
+
+type Meta struct {
+	RequestID string `json:"requestId" db:"request_id"`
+}
+
+type Page[T any] struct {
+	Items []T `json:"items" db:"-"`
+	Total int `json:"total" db:"total"`
+}
+
+type Envelope[T any] struct {
+	Data T    `json:"data" db:"data"`
+	Meta Meta `json:"meta" db:"-"`
+}
+
+type UserPage struct {
+	Page[User]
+	Cursor string `json:"cursor,omitempty" db:"-"`
+}
@@ -0,0 +1,204 @@
+// Code generated by stag. DO NOT EDIT.
+// Source file: envelope.go
+
+package sample
+
+// Meta_DB holds the db names of the fields of Meta.
+var Meta_DB = struct {
+	// Source: envelope.go:4
+	RequestID       string
+	AllDBFieldNames []string
+	AllGoFieldNames []string
+}{
+
+	RequestID:       "request_id",
+	AllDBFieldNames: []string{"request_id"},
+	AllGoFieldNames: []string{"RequestID"},
+}
+
+func IsValidMeta_DBField(f string) bool {
+	switch f {
+	case "request_id":
+		return true
+	}
+	return false
+}
+
+// Meta_DBGoNameFor returns the Go name of the field of Meta having the db name tagName, if there is one.
+func Meta_DBGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "request_id":
+		return "RequestID", true
+	}
+	return "", false
+}
+
+// Meta_DBTagNameFor returns the db name of the field of Meta having the Go name goName, if there is one.
+func Meta_DBTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "RequestID":
+		return "request_id", true
+	}
+	return "", false
+}
+
+var Meta_DB_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "request_id", GoName: "RequestID", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+}
+
+// Page_DB holds the db names of the fields of Page.
+var Page_DB = struct {
+	// Source: envelope.go:9
+	Total           string
+	AllDBFieldNames []string
+	AllGoFieldNames []string
+}{
+
+	Total:           "total",
+	AllDBFieldNames: []string{"total"},
+	AllGoFieldNames: []string{"Total"},
+}
+
+func IsValidPage_DBField(f string) bool {
+	switch f {
+	case "total":
+		return true
+	}
+	return false
+}
+
+// Page_DBGoNameFor returns the Go name of the field of Page having the db name tagName, if there is one.
+func Page_DBGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "total":
+		return "Total", true
+	}
+	return "", false
+}
+
+// Page_DBTagNameFor returns the db name of the field of Page having the Go name goName, if there is one.
+func Page_DBTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Total":
+		return "total", true
+	}
+	return "", false
+}
+
+var Page_DB_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "total", GoName: "Total", GoType: "int", Kind: "basic", Nullable: false, PkgPath: ""},
+}
+
+// Envelope_DB holds the db names of the fields of Envelope.
+var Envelope_DB = struct {
+	// Source: envelope.go:13
+	Data            string
+	AllDBFieldNames []string
+	AllGoFieldNames []string
+}{
+
+	Data:            "data",
+	AllDBFieldNames: []string{"data"},
+	AllGoFieldNames: []string{"Data"},
+}
+
+func IsValidEnvelope_DBField(f string) bool {
+	switch f {
+	case "data":
+		return true
+	}
+	return false
+}
+
+// Envelope_DBGoNameFor returns the Go name of the field of Envelope having the db name tagName, if there is one.
+func Envelope_DBGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "data":
+		return "Data", true
+	}
+	return "", false
+}
+
+// Envelope_DBTagNameFor returns the db name of the field of Envelope having the Go name goName, if there is one.
+func Envelope_DBTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Data":
+		return "data", true
+	}
+	return "", false
+}
+
+var Envelope_DB_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "data", GoName: "Data", GoType: "T", Kind: "typeparam", Nullable: false, PkgPath: ""},
+}
+
+// UserPage_DB holds the db names of the fields of UserPage.
+var UserPage_DB = struct {
+	// Source: envelope.go:9
+	Total           string
+	AllDBFieldNames []string
+	AllGoFieldNames []string
+}{
+
+	Total:           "total",
+	AllDBFieldNames: []string{"total"},
+	AllGoFieldNames: []string{"Total"},
+}
+
+func IsValidUserPage_DBField(f string) bool {
+	switch f {
+	case "total":
+		return true
+	}
+	return false
+}
+
+// UserPage_DBGoNameFor returns the Go name of the field of UserPage having the db name tagName, if there is one.
+func UserPage_DBGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "total":
+		return "Total", true
+	}
+	return "", false
+}
+
+// UserPage_DBTagNameFor returns the db name of the field of UserPage having the Go name goName, if there is one.
+func UserPage_DBTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Total":
+		return "total", true
+	}
+	return "", false
+}
+
+var UserPage_DB_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "total", GoName: "Total", GoType: "int", Kind: "basic", Nullable: false, PkgPath: ""},
+}
@@ -0,0 +1,260 @@
+// Code generated by stag. DO NOT EDIT.
+// Source file: envelope.go
+
+package sample
+
+import "strings"
+
+// Meta_JSON holds the json names of the fields of Meta.
+var Meta_JSON = struct {
+	// Source: envelope.go:4
+	RequestID         string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	RequestID:         "requestId",
+	AllJSONFieldNames: []string{"requestId"},
+	AllGoFieldNames:   []string{"RequestID"},
+}
+
+func IsValidMeta_JSONField(f string) bool {
+	switch f {
+	case "requestId":
+		return true
+	}
+	return false
+}
+
+// Meta_JSONGoNameFor returns the Go name of the field of Meta having the json name tagName, if there is one.
+func Meta_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "requestId":
+		return "RequestID", true
+	}
+	return "", false
+}
+
+// Meta_JSONTagNameFor returns the json name of the field of Meta having the Go name goName, if there is one.
+func Meta_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "RequestID":
+		return "requestId", true
+	}
+	return "", false
+}
+
+var Meta_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "requestId", GoName: "RequestID", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+}
+
+// Page_JSON holds the json names of the fields of Page.
+var Page_JSON = struct {
+	// Source: envelope.go:8
+	Items string
+	// Source: envelope.go:9
+	Total             string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	Items:             "items",
+	Total:             "total",
+	AllJSONFieldNames: []string{"items", "total"},
+	AllGoFieldNames:   []string{"Items", "Total"},
+}
+
+func IsValidPage_JSONField(f string) bool {
+	switch f {
+	case "items", "total":
+		return true
+	}
+	return false
+}
+
+// Page_JSONGoNameFor returns the Go name of the field of Page having the json name tagName, if there is one.
+func Page_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "items":
+		return "Items", true
+	case "total":
+		return "Total", true
+	}
+	return "", false
+}
+
+// Page_JSONTagNameFor returns the json name of the field of Page having the Go name goName, if there is one.
+func Page_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Items":
+		return "items", true
+	case "Total":
+		return "total", true
+	}
+	return "", false
+}
+
+var Page_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "items", GoName: "Items", GoType: "[]T", Kind: "slice", Nullable: true, PkgPath: ""},
+	{Name: "total", GoName: "Total", GoType: "int", Kind: "basic", Nullable: false, PkgPath: ""},
+}
+
+// Envelope_JSON holds the json names of the fields of Envelope.
+var Envelope_JSON = struct {
+	// Source: envelope.go:13
+	Data string
+	// Source: envelope.go:14
+	Meta              string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	Data:              "data",
+	Meta:              "meta",
+	AllJSONFieldNames: []string{"data", "meta"},
+	AllGoFieldNames:   []string{"Data", "Meta"},
+}
+
+func IsValidEnvelope_JSONField(f string) bool {
+	switch f {
+	case "data", "meta":
+		return true
+	}
+	return false
+}
+
+// Envelope_JSONGoNameFor returns the Go name of the field of Envelope having the json name tagName, if there is one.
+func Envelope_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "data":
+		return "Data", true
+	case "meta":
+		return "Meta", true
+	}
+	return "", false
+}
+
+// Envelope_JSONTagNameFor returns the json name of the field of Envelope having the Go name goName, if there is one.
+func Envelope_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Data":
+		return "data", true
+	case "Meta":
+		return "meta", true
+	}
+	return "", false
+}
+
+var Envelope_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "data", GoName: "Data", GoType: "T", Kind: "typeparam", Nullable: false, PkgPath: ""},
+	{Name: "meta", GoName: "Meta", GoType: "Meta", Kind: "struct", Nullable: false, PkgPath: "github.com/bradleygore/go-stag/sample"},
+}
+
+// UserPage_JSON holds the json names of the fields of UserPage.
+var UserPage_JSON = struct {
+	// Source: envelope.go:8
+	Items string
+	// Source: envelope.go:9
+	Total string
+	// Source: envelope.go:19
+	Cursor            string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	Items:             "items",
+	Total:             "total",
+	Cursor:            "cursor",
+	AllJSONFieldNames: []string{"items", "total", "cursor"},
+	AllGoFieldNames:   []string{"Items", "Total", "Cursor"},
+}
+
+func IsValidUserPage_JSONField(f string) bool {
+	switch f {
+	case "items", "total", "cursor":
+		return true
+	}
+	return false
+}
+
+// UserPage_JSONGoNameFor returns the Go name of the field of UserPage having the json name tagName, if there is one.
+func UserPage_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "items":
+		return "Items", true
+	case "total":
+		return "Total", true
+	case "cursor":
+		return "Cursor", true
+	}
+	return "", false
+}
+
+// UserPage_JSONTagNameFor returns the json name of the field of UserPage having the Go name goName, if there is one.
+func UserPage_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Items":
+		return "items", true
+	case "Total":
+		return "total", true
+	case "Cursor":
+		return "cursor", true
+	}
+	return "", false
+}
+
+var UserPage_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "items", GoName: "Items", GoType: "[]User", Kind: "slice", Nullable: true, PkgPath: ""},
+	{Name: "total", GoName: "Total", GoType: "int", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "cursor", GoName: "Cursor", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+}
+
+var UserPage_JSON_Options = struct {
+	Items  []string
+	Total  []string
+	Cursor []string
+}{
+	Cursor: []string{"omitempty"},
+}
+
+func HasUserPage_JSONFieldOption(f, opt string) bool {
+	var opts []string
+	switch f {
+	case "cursor":
+		opts = UserPage_JSON_Options.Cursor
+	}
+	for _, o := range opts {
+		if o == opt || strings.HasPrefix(o, opt+"=") {
+			return true
+		}
+	}
+	return false
+}
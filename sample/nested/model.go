@@ -1,5 +1,6 @@
 package nested
 
 type Model struct {
+	// ID is the primary key of every model
 	ID int64 `json:"id" db:"pk_id"`
 }
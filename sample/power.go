@@ -0,0 +1,7 @@
+package sample
+
+// Moderator embeds a struct declared in a sibling file
+type Moderator struct {
+	*PowerUser
+	Forums []string `json:"forums" db:"-"`
+}
@@ -0,0 +1,160 @@
+// Code generated by stag. DO NOT EDIT.
+// Source file: power.go
+
+package sample
+
+import "strings"
+
+// Moderator_DB holds the db names of the fields of Moderator.
+//
+// Moderator embeds a struct declared in a sibling file
+var Moderator_DB = struct {
+	// ID is the primary key of every model
+	//
+	// Source: nested/model.go:5
+	ID string
+	// FirstName is the given name
+	//
+	// Source: user.go:13
+	FirstName string
+	// Source: user.go:14
+	LastName string
+	// Age is kept for older clients.
+	//
+	// Deprecated: derive it from DOB instead.
+	//
+	// Source: user.go:18
+	Age string
+	// date of birth
+	//
+	// Source: user.go:19
+	DOB string
+	// Source: user.go:21
+	JSONBlankName string
+	// Source: user.go:26
+	SpecialPower string
+	// Source: user.go:27
+	JSONSkip string
+	// Source: user.go:28
+	DBBlankName     string
+	AllDBFieldNames []string
+	AllGoFieldNames []string
+}{
+
+	ID:              "pk_id",
+	FirstName:       "first_name",
+	LastName:        "last_name",
+	Age:             "age_years",
+	DOB:             "bday",
+	JSONBlankName:   "json_blank_name",
+	SpecialPower:    "super_power",
+	JSONSkip:        "json_skip",
+	DBBlankName:     "dbblankname",
+	AllDBFieldNames: []string{"pk_id", "first_name", "last_name", "age_years", "bday", "json_blank_name", "super_power", "json_skip", "dbblankname"},
+	AllGoFieldNames: []string{"ID", "FirstName", "LastName", "Age", "DOB", "JSONBlankName", "SpecialPower", "JSONSkip", "DBBlankName"},
+}
+
+func IsValidModerator_DBField(f string) bool {
+	switch f {
+	case "pk_id", "first_name", "last_name", "age_years", "bday", "json_blank_name", "super_power", "json_skip", "dbblankname":
+		return true
+	}
+	return false
+}
+
+// Moderator_DBGoNameFor returns the Go name of the field of Moderator having the db name tagName, if there is one.
+func Moderator_DBGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "pk_id":
+		return "ID", true
+	case "first_name":
+		return "FirstName", true
+	case "last_name":
+		return "LastName", true
+	case "age_years":
+		return "Age", true
+	case "bday":
+		return "DOB", true
+	case "json_blank_name":
+		return "JSONBlankName", true
+	case "super_power":
+		return "SpecialPower", true
+	case "json_skip":
+		return "JSONSkip", true
+	case "dbblankname":
+		return "DBBlankName", true
+	}
+	return "", false
+}
+
+// Moderator_DBTagNameFor returns the db name of the field of Moderator having the Go name goName, if there is one.
+func Moderator_DBTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "ID":
+		return "pk_id", true
+	case "FirstName":
+		return "first_name", true
+	case "LastName":
+		return "last_name", true
+	case "Age":
+		return "age_years", true
+	case "DOB":
+		return "bday", true
+	case "JSONBlankName":
+		return "json_blank_name", true
+	case "SpecialPower":
+		return "super_power", true
+	case "JSONSkip":
+		return "json_skip", true
+	case "DBBlankName":
+		return "dbblankname", true
+	}
+	return "", false
+}
+
+var Moderator_DB_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "pk_id", GoName: "ID", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "first_name", GoName: "FirstName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "last_name", GoName: "LastName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "age_years", GoName: "Age", GoType: "uint16", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "bday", GoName: "DOB", GoType: "*time.Time", Kind: "pointer", Nullable: true, PkgPath: "time"},
+	{Name: "json_blank_name", GoName: "JSONBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+	{Name: "super_power", GoName: "SpecialPower", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "json_skip", GoName: "JSONSkip", GoType: "uint", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dbblankname", GoName: "DBBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+}
+
+var Moderator_DB_Options = struct {
+	ID            []string
+	FirstName     []string
+	LastName      []string
+	Age           []string
+	DOB           []string
+	JSONBlankName []string
+	SpecialPower  []string
+	JSONSkip      []string
+	DBBlankName   []string
+}{
+	DBBlankName: []string{"bogus"},
+}
+
+func HasModerator_DBFieldOption(f, opt string) bool {
+	var opts []string
+	switch f {
+	case "dbblankname":
+		opts = Moderator_DB_Options.DBBlankName
+	}
+	for _, o := range opts {
+		if o == opt || strings.HasPrefix(o, opt+"=") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,169 @@
+// Code generated by stag. DO NOT EDIT.
+// Source file: power.go
+
+package sample
+
+import "strings"
+
+// Moderator_JSON holds the json names of the fields of Moderator.
+//
+// Moderator embeds a struct declared in a sibling file
+var Moderator_JSON = struct {
+	// ID is the primary key of every model
+	//
+	// Source: nested/model.go:5
+	ID string
+	// FirstName is the given name
+	//
+	// Source: user.go:13
+	FirstName string
+	// Source: user.go:14
+	LastName string
+	// Age is kept for older clients.
+	//
+	// Deprecated: derive it from DOB instead.
+	//
+	// Source: user.go:18
+	Age string
+	// date of birth
+	//
+	// Source: user.go:19
+	DOB string
+	// Source: user.go:20
+	DBSkip string
+	// Source: user.go:21
+	JSONBlankName string
+	// Source: user.go:26
+	SpecialPower string
+	// Source: user.go:28
+	DBBlankName string
+	// Source: power.go:6
+	Forums            string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	ID:                "id",
+	FirstName:         "fName",
+	LastName:          "lName",
+	Age:               "age",
+	DOB:               "dob",
+	DBSkip:            "dbSkip",
+	JSONBlankName:     "JSONBlankName",
+	SpecialPower:      "superPower",
+	DBBlankName:       "dbBlanker",
+	Forums:            "forums",
+	AllJSONFieldNames: []string{"id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName", "superPower", "dbBlanker", "forums"},
+	AllGoFieldNames:   []string{"ID", "FirstName", "LastName", "Age", "DOB", "DBSkip", "JSONBlankName", "SpecialPower", "DBBlankName", "Forums"},
+}
+
+func IsValidModerator_JSONField(f string) bool {
+	switch f {
+	case "id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName", "superPower", "dbBlanker", "forums":
+		return true
+	}
+	return false
+}
+
+// Moderator_JSONGoNameFor returns the Go name of the field of Moderator having the json name tagName, if there is one.
+func Moderator_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "id":
+		return "ID", true
+	case "fName":
+		return "FirstName", true
+	case "lName":
+		return "LastName", true
+	case "age":
+		return "Age", true
+	case "dob":
+		return "DOB", true
+	case "dbSkip":
+		return "DBSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	case "superPower":
+		return "SpecialPower", true
+	case "dbBlanker":
+		return "DBBlankName", true
+	case "forums":
+		return "Forums", true
+	}
+	return "", false
+}
+
+// Moderator_JSONTagNameFor returns the json name of the field of Moderator having the Go name goName, if there is one.
+func Moderator_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "ID":
+		return "id", true
+	case "FirstName":
+		return "fName", true
+	case "LastName":
+		return "lName", true
+	case "Age":
+		return "age", true
+	case "DOB":
+		return "dob", true
+	case "DBSkip":
+		return "dbSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	case "SpecialPower":
+		return "superPower", true
+	case "DBBlankName":
+		return "dbBlanker", true
+	case "Forums":
+		return "forums", true
+	}
+	return "", false
+}
+
+var Moderator_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "id", GoName: "ID", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "fName", GoName: "FirstName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "lName", GoName: "LastName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "age", GoName: "Age", GoType: "uint16", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dob", GoName: "DOB", GoType: "*time.Time", Kind: "pointer", Nullable: true, PkgPath: "time"},
+	{Name: "dbSkip", GoName: "DBSkip", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "JSONBlankName", GoName: "JSONBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+	{Name: "superPower", GoName: "SpecialPower", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dbBlanker", GoName: "DBBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+	{Name: "forums", GoName: "Forums", GoType: "[]string", Kind: "slice", Nullable: true, PkgPath: ""},
+}
+
+var Moderator_JSON_Options = struct {
+	ID            []string
+	FirstName     []string
+	LastName      []string
+	Age           []string
+	DOB           []string
+	DBSkip        []string
+	JSONBlankName []string
+	SpecialPower  []string
+	DBBlankName   []string
+	Forums        []string
+}{
+	JSONBlankName: []string{"omitempty"},
+}
+
+func HasModerator_JSONFieldOption(f, opt string) bool {
+	var opts []string
+	switch f {
+	case "JSONBlankName":
+		opts = Moderator_JSON_Options.JSONBlankName
+	}
+	for _, o := range opts {
+		if o == opt || strings.HasPrefix(o, opt+"=") {
+			return true
+		}
+	}
+	return false
+}
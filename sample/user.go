@@ -6,12 +6,17 @@ import (
 	m "github.com/bradleygore/go-stag/sample/nested"
 )
 
+// User is a registered account holder
 type User struct {
 	m.Model
-	FirstName     string     `json:"fName" db:"first_name"`
-	LastName      string     `json:"lName" db:"last_name"`
+	// FirstName is the given name
+	FirstName string `json:"fName" db:"first_name"`
+	LastName  string `json:"lName" db:"last_name"`
+	// Age is kept for older clients.
+	//
+	// Deprecated: derive it from DOB instead.
 	Age           uint16     `json:"age" db:"age_years"`
-	DOB           *time.Time `json:"dob" db:"bday"`
+	DOB           *time.Time `json:"dob" db:"bday"` // date of birth
 	DBSkip        int64      `json:"dbSkip" db:"-"`
 	JSONBlankName []float64  `json:",omitempty" db:"json_blank_name"`
 }
@@ -22,3 +27,9 @@ type PowerUser struct {
 	JSONSkip     uint      `json:"-" db:"json_skip"`
 	DBBlankName  []float64 `json:"dbBlanker" db:",bogus"`
 }
+
+// Admin is the API view of a User
+type Admin User
+
+// Record is the shared persistence base model
+type Record = m.Model
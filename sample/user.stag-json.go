@@ -3,66 +3,482 @@
 
 package sample
 
+import "strings"
+
+// User_JSON holds the json names of the fields of User.
+//
+// User is a registered account holder
 var User_JSON = struct {
-	FirstName         string
-	LastName          string
-	Age               string
-	DOB               string
-	DBSkip            string
+	// ID is the primary key of every model
+	//
+	// Source: nested/model.go:5
+	ID string
+	// FirstName is the given name
+	//
+	// Source: user.go:13
+	FirstName string
+	// Source: user.go:14
+	LastName string
+	// Age is kept for older clients.
+	//
+	// Deprecated: derive it from DOB instead.
+	//
+	// Source: user.go:18
+	Age string
+	// date of birth
+	//
+	// Source: user.go:19
+	DOB string
+	// Source: user.go:20
+	DBSkip string
+	// Source: user.go:21
 	JSONBlankName     string
-	ID                string
 	AllJSONFieldNames []string
+	AllGoFieldNames   []string
 }{
 
+	ID:                "id",
 	FirstName:         "fName",
 	LastName:          "lName",
 	Age:               "age",
 	DOB:               "dob",
 	DBSkip:            "dbSkip",
 	JSONBlankName:     "JSONBlankName",
-	ID:                "id",
-	AllJSONFieldNames: []string{"fName", "lName", "age", "dob", "dbSkip", "JSONBlankName", "id"},
+	AllJSONFieldNames: []string{"id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName"},
+	AllGoFieldNames:   []string{"ID", "FirstName", "LastName", "Age", "DOB", "DBSkip", "JSONBlankName"},
 }
 
 func IsValidUser_JSONField(f string) bool {
-	for _, fn := range User_JSON.AllJSONFieldNames {
-		if fn == f {
+	switch f {
+	case "id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName":
+		return true
+	}
+	return false
+}
+
+// User_JSONGoNameFor returns the Go name of the field of User having the json name tagName, if there is one.
+func User_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "id":
+		return "ID", true
+	case "fName":
+		return "FirstName", true
+	case "lName":
+		return "LastName", true
+	case "age":
+		return "Age", true
+	case "dob":
+		return "DOB", true
+	case "dbSkip":
+		return "DBSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	}
+	return "", false
+}
+
+// User_JSONTagNameFor returns the json name of the field of User having the Go name goName, if there is one.
+func User_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "ID":
+		return "id", true
+	case "FirstName":
+		return "fName", true
+	case "LastName":
+		return "lName", true
+	case "Age":
+		return "age", true
+	case "DOB":
+		return "dob", true
+	case "DBSkip":
+		return "dbSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	}
+	return "", false
+}
+
+var User_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "id", GoName: "ID", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "fName", GoName: "FirstName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "lName", GoName: "LastName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "age", GoName: "Age", GoType: "uint16", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dob", GoName: "DOB", GoType: "*time.Time", Kind: "pointer", Nullable: true, PkgPath: "time"},
+	{Name: "dbSkip", GoName: "DBSkip", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "JSONBlankName", GoName: "JSONBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+}
+
+var User_JSON_Options = struct {
+	ID            []string
+	FirstName     []string
+	LastName      []string
+	Age           []string
+	DOB           []string
+	DBSkip        []string
+	JSONBlankName []string
+}{
+	JSONBlankName: []string{"omitempty"},
+}
+
+func HasUser_JSONFieldOption(f, opt string) bool {
+	var opts []string
+	switch f {
+	case "JSONBlankName":
+		opts = User_JSON_Options.JSONBlankName
+	}
+	for _, o := range opts {
+		if o == opt || strings.HasPrefix(o, opt+"=") {
 			return true
 		}
 	}
 	return false
 }
 
+// PowerUser_JSON holds the json names of the fields of PowerUser.
 var PowerUser_JSON = struct {
-	SpecialPower      string
+	// ID is the primary key of every model
+	//
+	// Source: nested/model.go:5
+	ID string
+	// FirstName is the given name
+	//
+	// Source: user.go:13
+	FirstName string
+	// Source: user.go:14
+	LastName string
+	// Age is kept for older clients.
+	//
+	// Deprecated: derive it from DOB instead.
+	//
+	// Source: user.go:18
+	Age string
+	// date of birth
+	//
+	// Source: user.go:19
+	DOB string
+	// Source: user.go:20
+	DBSkip string
+	// Source: user.go:21
+	JSONBlankName string
+	// Source: user.go:26
+	SpecialPower string
+	// Source: user.go:28
 	DBBlankName       string
-	FirstName         string
-	LastName          string
-	Age               string
-	DOB               string
-	DBSkip            string
-	JSONBlankName     string
-	ID                string
 	AllJSONFieldNames []string
+	AllGoFieldNames   []string
 }{
 
+	ID:                "id",
+	FirstName:         "fName",
+	LastName:          "lName",
+	Age:               "age",
+	DOB:               "dob",
+	DBSkip:            "dbSkip",
+	JSONBlankName:     "JSONBlankName",
 	SpecialPower:      "superPower",
 	DBBlankName:       "dbBlanker",
+	AllJSONFieldNames: []string{"id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName", "superPower", "dbBlanker"},
+	AllGoFieldNames:   []string{"ID", "FirstName", "LastName", "Age", "DOB", "DBSkip", "JSONBlankName", "SpecialPower", "DBBlankName"},
+}
+
+func IsValidPowerUser_JSONField(f string) bool {
+	switch f {
+	case "id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName", "superPower", "dbBlanker":
+		return true
+	}
+	return false
+}
+
+// PowerUser_JSONGoNameFor returns the Go name of the field of PowerUser having the json name tagName, if there is one.
+func PowerUser_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "id":
+		return "ID", true
+	case "fName":
+		return "FirstName", true
+	case "lName":
+		return "LastName", true
+	case "age":
+		return "Age", true
+	case "dob":
+		return "DOB", true
+	case "dbSkip":
+		return "DBSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	case "superPower":
+		return "SpecialPower", true
+	case "dbBlanker":
+		return "DBBlankName", true
+	}
+	return "", false
+}
+
+// PowerUser_JSONTagNameFor returns the json name of the field of PowerUser having the Go name goName, if there is one.
+func PowerUser_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "ID":
+		return "id", true
+	case "FirstName":
+		return "fName", true
+	case "LastName":
+		return "lName", true
+	case "Age":
+		return "age", true
+	case "DOB":
+		return "dob", true
+	case "DBSkip":
+		return "dbSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	case "SpecialPower":
+		return "superPower", true
+	case "DBBlankName":
+		return "dbBlanker", true
+	}
+	return "", false
+}
+
+var PowerUser_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "id", GoName: "ID", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "fName", GoName: "FirstName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "lName", GoName: "LastName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "age", GoName: "Age", GoType: "uint16", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dob", GoName: "DOB", GoType: "*time.Time", Kind: "pointer", Nullable: true, PkgPath: "time"},
+	{Name: "dbSkip", GoName: "DBSkip", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "JSONBlankName", GoName: "JSONBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+	{Name: "superPower", GoName: "SpecialPower", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dbBlanker", GoName: "DBBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+}
+
+var PowerUser_JSON_Options = struct {
+	ID            []string
+	FirstName     []string
+	LastName      []string
+	Age           []string
+	DOB           []string
+	DBSkip        []string
+	JSONBlankName []string
+	SpecialPower  []string
+	DBBlankName   []string
+}{
+	JSONBlankName: []string{"omitempty"},
+}
+
+func HasPowerUser_JSONFieldOption(f, opt string) bool {
+	var opts []string
+	switch f {
+	case "JSONBlankName":
+		opts = PowerUser_JSON_Options.JSONBlankName
+	}
+	for _, o := range opts {
+		if o == opt || strings.HasPrefix(o, opt+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// Admin_JSON holds the json names of the fields of Admin.
+//
+// Admin is the API view of a User
+var Admin_JSON = struct {
+	// ID is the primary key of every model
+	//
+	// Source: nested/model.go:5
+	ID string
+	// FirstName is the given name
+	//
+	// Source: user.go:13
+	FirstName string
+	// Source: user.go:14
+	LastName string
+	// Age is kept for older clients.
+	//
+	// Deprecated: derive it from DOB instead.
+	//
+	// Source: user.go:18
+	Age string
+	// date of birth
+	//
+	// Source: user.go:19
+	DOB string
+	// Source: user.go:20
+	DBSkip string
+	// Source: user.go:21
+	JSONBlankName     string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	ID:                "id",
 	FirstName:         "fName",
 	LastName:          "lName",
 	Age:               "age",
 	DOB:               "dob",
 	DBSkip:            "dbSkip",
 	JSONBlankName:     "JSONBlankName",
-	ID:                "id",
-	AllJSONFieldNames: []string{"superPower", "dbBlanker", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName", "id"},
+	AllJSONFieldNames: []string{"id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName"},
+	AllGoFieldNames:   []string{"ID", "FirstName", "LastName", "Age", "DOB", "DBSkip", "JSONBlankName"},
 }
 
-func IsValidPowerUser_JSONField(f string) bool {
-	for _, fn := range PowerUser_JSON.AllJSONFieldNames {
-		if fn == f {
+func IsValidAdmin_JSONField(f string) bool {
+	switch f {
+	case "id", "fName", "lName", "age", "dob", "dbSkip", "JSONBlankName":
+		return true
+	}
+	return false
+}
+
+// Admin_JSONGoNameFor returns the Go name of the field of Admin having the json name tagName, if there is one.
+func Admin_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "id":
+		return "ID", true
+	case "fName":
+		return "FirstName", true
+	case "lName":
+		return "LastName", true
+	case "age":
+		return "Age", true
+	case "dob":
+		return "DOB", true
+	case "dbSkip":
+		return "DBSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	}
+	return "", false
+}
+
+// Admin_JSONTagNameFor returns the json name of the field of Admin having the Go name goName, if there is one.
+func Admin_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "ID":
+		return "id", true
+	case "FirstName":
+		return "fName", true
+	case "LastName":
+		return "lName", true
+	case "Age":
+		return "age", true
+	case "DOB":
+		return "dob", true
+	case "DBSkip":
+		return "dbSkip", true
+	case "JSONBlankName":
+		return "JSONBlankName", true
+	}
+	return "", false
+}
+
+var Admin_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "id", GoName: "ID", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "fName", GoName: "FirstName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "lName", GoName: "LastName", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "age", GoName: "Age", GoType: "uint16", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "dob", GoName: "DOB", GoType: "*time.Time", Kind: "pointer", Nullable: true, PkgPath: "time"},
+	{Name: "dbSkip", GoName: "DBSkip", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "JSONBlankName", GoName: "JSONBlankName", GoType: "[]float64", Kind: "slice", Nullable: true, PkgPath: ""},
+}
+
+var Admin_JSON_Options = struct {
+	ID            []string
+	FirstName     []string
+	LastName      []string
+	Age           []string
+	DOB           []string
+	DBSkip        []string
+	JSONBlankName []string
+}{
+	JSONBlankName: []string{"omitempty"},
+}
+
+func HasAdmin_JSONFieldOption(f, opt string) bool {
+	var opts []string
+	switch f {
+	case "JSONBlankName":
+		opts = Admin_JSON_Options.JSONBlankName
+	}
+	for _, o := range opts {
+		if o == opt || strings.HasPrefix(o, opt+"=") {
 			return true
 		}
 	}
 	return false
 }
+
+// Record_JSON holds the json names of the fields of Record.
+//
+// Record is the shared persistence base model
+var Record_JSON = struct {
+	// ID is the primary key of every model
+	//
+	// Source: nested/model.go:5
+	ID                string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	ID:                "id",
+	AllJSONFieldNames: []string{"id"},
+	AllGoFieldNames:   []string{"ID"},
+}
+
+func IsValidRecord_JSONField(f string) bool {
+	switch f {
+	case "id":
+		return true
+	}
+	return false
+}
+
+// Record_JSONGoNameFor returns the Go name of the field of Record having the json name tagName, if there is one.
+func Record_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "id":
+		return "ID", true
+	}
+	return "", false
+}
+
+// Record_JSONTagNameFor returns the json name of the field of Record having the Go name goName, if there is one.
+func Record_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "ID":
+		return "id", true
+	}
+	return "", false
+}
+
+var Record_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "id", GoName: "ID", GoType: "int64", Kind: "basic", Nullable: false, PkgPath: ""},
+}
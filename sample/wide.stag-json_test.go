@@ -0,0 +1,1648 @@
+// Code generated by stag. DO NOT EDIT.
+// Source file: wide_test.go
+
+package sample
+
+// Wide_JSON holds the json names of the fields of Wide.
+//
+// Wide is a table about as wide as those IsValid is meant for, having 200 columns
+var Wide_JSON = struct {
+	// Source: wide_test.go:5
+	Column001 string
+	// Source: wide_test.go:6
+	Column002 string
+	// Source: wide_test.go:7
+	Column003 string
+	// Source: wide_test.go:8
+	Column004 string
+	// Source: wide_test.go:9
+	Column005 string
+	// Source: wide_test.go:10
+	Column006 string
+	// Source: wide_test.go:11
+	Column007 string
+	// Source: wide_test.go:12
+	Column008 string
+	// Source: wide_test.go:13
+	Column009 string
+	// Source: wide_test.go:14
+	Column010 string
+	// Source: wide_test.go:15
+	Column011 string
+	// Source: wide_test.go:16
+	Column012 string
+	// Source: wide_test.go:17
+	Column013 string
+	// Source: wide_test.go:18
+	Column014 string
+	// Source: wide_test.go:19
+	Column015 string
+	// Source: wide_test.go:20
+	Column016 string
+	// Source: wide_test.go:21
+	Column017 string
+	// Source: wide_test.go:22
+	Column018 string
+	// Source: wide_test.go:23
+	Column019 string
+	// Source: wide_test.go:24
+	Column020 string
+	// Source: wide_test.go:25
+	Column021 string
+	// Source: wide_test.go:26
+	Column022 string
+	// Source: wide_test.go:27
+	Column023 string
+	// Source: wide_test.go:28
+	Column024 string
+	// Source: wide_test.go:29
+	Column025 string
+	// Source: wide_test.go:30
+	Column026 string
+	// Source: wide_test.go:31
+	Column027 string
+	// Source: wide_test.go:32
+	Column028 string
+	// Source: wide_test.go:33
+	Column029 string
+	// Source: wide_test.go:34
+	Column030 string
+	// Source: wide_test.go:35
+	Column031 string
+	// Source: wide_test.go:36
+	Column032 string
+	// Source: wide_test.go:37
+	Column033 string
+	// Source: wide_test.go:38
+	Column034 string
+	// Source: wide_test.go:39
+	Column035 string
+	// Source: wide_test.go:40
+	Column036 string
+	// Source: wide_test.go:41
+	Column037 string
+	// Source: wide_test.go:42
+	Column038 string
+	// Source: wide_test.go:43
+	Column039 string
+	// Source: wide_test.go:44
+	Column040 string
+	// Source: wide_test.go:45
+	Column041 string
+	// Source: wide_test.go:46
+	Column042 string
+	// Source: wide_test.go:47
+	Column043 string
+	// Source: wide_test.go:48
+	Column044 string
+	// Source: wide_test.go:49
+	Column045 string
+	// Source: wide_test.go:50
+	Column046 string
+	// Source: wide_test.go:51
+	Column047 string
+	// Source: wide_test.go:52
+	Column048 string
+	// Source: wide_test.go:53
+	Column049 string
+	// Source: wide_test.go:54
+	Column050 string
+	// Source: wide_test.go:55
+	Column051 string
+	// Source: wide_test.go:56
+	Column052 string
+	// Source: wide_test.go:57
+	Column053 string
+	// Source: wide_test.go:58
+	Column054 string
+	// Source: wide_test.go:59
+	Column055 string
+	// Source: wide_test.go:60
+	Column056 string
+	// Source: wide_test.go:61
+	Column057 string
+	// Source: wide_test.go:62
+	Column058 string
+	// Source: wide_test.go:63
+	Column059 string
+	// Source: wide_test.go:64
+	Column060 string
+	// Source: wide_test.go:65
+	Column061 string
+	// Source: wide_test.go:66
+	Column062 string
+	// Source: wide_test.go:67
+	Column063 string
+	// Source: wide_test.go:68
+	Column064 string
+	// Source: wide_test.go:69
+	Column065 string
+	// Source: wide_test.go:70
+	Column066 string
+	// Source: wide_test.go:71
+	Column067 string
+	// Source: wide_test.go:72
+	Column068 string
+	// Source: wide_test.go:73
+	Column069 string
+	// Source: wide_test.go:74
+	Column070 string
+	// Source: wide_test.go:75
+	Column071 string
+	// Source: wide_test.go:76
+	Column072 string
+	// Source: wide_test.go:77
+	Column073 string
+	// Source: wide_test.go:78
+	Column074 string
+	// Source: wide_test.go:79
+	Column075 string
+	// Source: wide_test.go:80
+	Column076 string
+	// Source: wide_test.go:81
+	Column077 string
+	// Source: wide_test.go:82
+	Column078 string
+	// Source: wide_test.go:83
+	Column079 string
+	// Source: wide_test.go:84
+	Column080 string
+	// Source: wide_test.go:85
+	Column081 string
+	// Source: wide_test.go:86
+	Column082 string
+	// Source: wide_test.go:87
+	Column083 string
+	// Source: wide_test.go:88
+	Column084 string
+	// Source: wide_test.go:89
+	Column085 string
+	// Source: wide_test.go:90
+	Column086 string
+	// Source: wide_test.go:91
+	Column087 string
+	// Source: wide_test.go:92
+	Column088 string
+	// Source: wide_test.go:93
+	Column089 string
+	// Source: wide_test.go:94
+	Column090 string
+	// Source: wide_test.go:95
+	Column091 string
+	// Source: wide_test.go:96
+	Column092 string
+	// Source: wide_test.go:97
+	Column093 string
+	// Source: wide_test.go:98
+	Column094 string
+	// Source: wide_test.go:99
+	Column095 string
+	// Source: wide_test.go:100
+	Column096 string
+	// Source: wide_test.go:101
+	Column097 string
+	// Source: wide_test.go:102
+	Column098 string
+	// Source: wide_test.go:103
+	Column099 string
+	// Source: wide_test.go:104
+	Column100 string
+	// Source: wide_test.go:105
+	Column101 string
+	// Source: wide_test.go:106
+	Column102 string
+	// Source: wide_test.go:107
+	Column103 string
+	// Source: wide_test.go:108
+	Column104 string
+	// Source: wide_test.go:109
+	Column105 string
+	// Source: wide_test.go:110
+	Column106 string
+	// Source: wide_test.go:111
+	Column107 string
+	// Source: wide_test.go:112
+	Column108 string
+	// Source: wide_test.go:113
+	Column109 string
+	// Source: wide_test.go:114
+	Column110 string
+	// Source: wide_test.go:115
+	Column111 string
+	// Source: wide_test.go:116
+	Column112 string
+	// Source: wide_test.go:117
+	Column113 string
+	// Source: wide_test.go:118
+	Column114 string
+	// Source: wide_test.go:119
+	Column115 string
+	// Source: wide_test.go:120
+	Column116 string
+	// Source: wide_test.go:121
+	Column117 string
+	// Source: wide_test.go:122
+	Column118 string
+	// Source: wide_test.go:123
+	Column119 string
+	// Source: wide_test.go:124
+	Column120 string
+	// Source: wide_test.go:125
+	Column121 string
+	// Source: wide_test.go:126
+	Column122 string
+	// Source: wide_test.go:127
+	Column123 string
+	// Source: wide_test.go:128
+	Column124 string
+	// Source: wide_test.go:129
+	Column125 string
+	// Source: wide_test.go:130
+	Column126 string
+	// Source: wide_test.go:131
+	Column127 string
+	// Source: wide_test.go:132
+	Column128 string
+	// Source: wide_test.go:133
+	Column129 string
+	// Source: wide_test.go:134
+	Column130 string
+	// Source: wide_test.go:135
+	Column131 string
+	// Source: wide_test.go:136
+	Column132 string
+	// Source: wide_test.go:137
+	Column133 string
+	// Source: wide_test.go:138
+	Column134 string
+	// Source: wide_test.go:139
+	Column135 string
+	// Source: wide_test.go:140
+	Column136 string
+	// Source: wide_test.go:141
+	Column137 string
+	// Source: wide_test.go:142
+	Column138 string
+	// Source: wide_test.go:143
+	Column139 string
+	// Source: wide_test.go:144
+	Column140 string
+	// Source: wide_test.go:145
+	Column141 string
+	// Source: wide_test.go:146
+	Column142 string
+	// Source: wide_test.go:147
+	Column143 string
+	// Source: wide_test.go:148
+	Column144 string
+	// Source: wide_test.go:149
+	Column145 string
+	// Source: wide_test.go:150
+	Column146 string
+	// Source: wide_test.go:151
+	Column147 string
+	// Source: wide_test.go:152
+	Column148 string
+	// Source: wide_test.go:153
+	Column149 string
+	// Source: wide_test.go:154
+	Column150 string
+	// Source: wide_test.go:155
+	Column151 string
+	// Source: wide_test.go:156
+	Column152 string
+	// Source: wide_test.go:157
+	Column153 string
+	// Source: wide_test.go:158
+	Column154 string
+	// Source: wide_test.go:159
+	Column155 string
+	// Source: wide_test.go:160
+	Column156 string
+	// Source: wide_test.go:161
+	Column157 string
+	// Source: wide_test.go:162
+	Column158 string
+	// Source: wide_test.go:163
+	Column159 string
+	// Source: wide_test.go:164
+	Column160 string
+	// Source: wide_test.go:165
+	Column161 string
+	// Source: wide_test.go:166
+	Column162 string
+	// Source: wide_test.go:167
+	Column163 string
+	// Source: wide_test.go:168
+	Column164 string
+	// Source: wide_test.go:169
+	Column165 string
+	// Source: wide_test.go:170
+	Column166 string
+	// Source: wide_test.go:171
+	Column167 string
+	// Source: wide_test.go:172
+	Column168 string
+	// Source: wide_test.go:173
+	Column169 string
+	// Source: wide_test.go:174
+	Column170 string
+	// Source: wide_test.go:175
+	Column171 string
+	// Source: wide_test.go:176
+	Column172 string
+	// Source: wide_test.go:177
+	Column173 string
+	// Source: wide_test.go:178
+	Column174 string
+	// Source: wide_test.go:179
+	Column175 string
+	// Source: wide_test.go:180
+	Column176 string
+	// Source: wide_test.go:181
+	Column177 string
+	// Source: wide_test.go:182
+	Column178 string
+	// Source: wide_test.go:183
+	Column179 string
+	// Source: wide_test.go:184
+	Column180 string
+	// Source: wide_test.go:185
+	Column181 string
+	// Source: wide_test.go:186
+	Column182 string
+	// Source: wide_test.go:187
+	Column183 string
+	// Source: wide_test.go:188
+	Column184 string
+	// Source: wide_test.go:189
+	Column185 string
+	// Source: wide_test.go:190
+	Column186 string
+	// Source: wide_test.go:191
+	Column187 string
+	// Source: wide_test.go:192
+	Column188 string
+	// Source: wide_test.go:193
+	Column189 string
+	// Source: wide_test.go:194
+	Column190 string
+	// Source: wide_test.go:195
+	Column191 string
+	// Source: wide_test.go:196
+	Column192 string
+	// Source: wide_test.go:197
+	Column193 string
+	// Source: wide_test.go:198
+	Column194 string
+	// Source: wide_test.go:199
+	Column195 string
+	// Source: wide_test.go:200
+	Column196 string
+	// Source: wide_test.go:201
+	Column197 string
+	// Source: wide_test.go:202
+	Column198 string
+	// Source: wide_test.go:203
+	Column199 string
+	// Source: wide_test.go:204
+	Column200         string
+	AllJSONFieldNames []string
+	AllGoFieldNames   []string
+}{
+
+	Column001:         "column_001",
+	Column002:         "column_002",
+	Column003:         "column_003",
+	Column004:         "column_004",
+	Column005:         "column_005",
+	Column006:         "column_006",
+	Column007:         "column_007",
+	Column008:         "column_008",
+	Column009:         "column_009",
+	Column010:         "column_010",
+	Column011:         "column_011",
+	Column012:         "column_012",
+	Column013:         "column_013",
+	Column014:         "column_014",
+	Column015:         "column_015",
+	Column016:         "column_016",
+	Column017:         "column_017",
+	Column018:         "column_018",
+	Column019:         "column_019",
+	Column020:         "column_020",
+	Column021:         "column_021",
+	Column022:         "column_022",
+	Column023:         "column_023",
+	Column024:         "column_024",
+	Column025:         "column_025",
+	Column026:         "column_026",
+	Column027:         "column_027",
+	Column028:         "column_028",
+	Column029:         "column_029",
+	Column030:         "column_030",
+	Column031:         "column_031",
+	Column032:         "column_032",
+	Column033:         "column_033",
+	Column034:         "column_034",
+	Column035:         "column_035",
+	Column036:         "column_036",
+	Column037:         "column_037",
+	Column038:         "column_038",
+	Column039:         "column_039",
+	Column040:         "column_040",
+	Column041:         "column_041",
+	Column042:         "column_042",
+	Column043:         "column_043",
+	Column044:         "column_044",
+	Column045:         "column_045",
+	Column046:         "column_046",
+	Column047:         "column_047",
+	Column048:         "column_048",
+	Column049:         "column_049",
+	Column050:         "column_050",
+	Column051:         "column_051",
+	Column052:         "column_052",
+	Column053:         "column_053",
+	Column054:         "column_054",
+	Column055:         "column_055",
+	Column056:         "column_056",
+	Column057:         "column_057",
+	Column058:         "column_058",
+	Column059:         "column_059",
+	Column060:         "column_060",
+	Column061:         "column_061",
+	Column062:         "column_062",
+	Column063:         "column_063",
+	Column064:         "column_064",
+	Column065:         "column_065",
+	Column066:         "column_066",
+	Column067:         "column_067",
+	Column068:         "column_068",
+	Column069:         "column_069",
+	Column070:         "column_070",
+	Column071:         "column_071",
+	Column072:         "column_072",
+	Column073:         "column_073",
+	Column074:         "column_074",
+	Column075:         "column_075",
+	Column076:         "column_076",
+	Column077:         "column_077",
+	Column078:         "column_078",
+	Column079:         "column_079",
+	Column080:         "column_080",
+	Column081:         "column_081",
+	Column082:         "column_082",
+	Column083:         "column_083",
+	Column084:         "column_084",
+	Column085:         "column_085",
+	Column086:         "column_086",
+	Column087:         "column_087",
+	Column088:         "column_088",
+	Column089:         "column_089",
+	Column090:         "column_090",
+	Column091:         "column_091",
+	Column092:         "column_092",
+	Column093:         "column_093",
+	Column094:         "column_094",
+	Column095:         "column_095",
+	Column096:         "column_096",
+	Column097:         "column_097",
+	Column098:         "column_098",
+	Column099:         "column_099",
+	Column100:         "column_100",
+	Column101:         "column_101",
+	Column102:         "column_102",
+	Column103:         "column_103",
+	Column104:         "column_104",
+	Column105:         "column_105",
+	Column106:         "column_106",
+	Column107:         "column_107",
+	Column108:         "column_108",
+	Column109:         "column_109",
+	Column110:         "column_110",
+	Column111:         "column_111",
+	Column112:         "column_112",
+	Column113:         "column_113",
+	Column114:         "column_114",
+	Column115:         "column_115",
+	Column116:         "column_116",
+	Column117:         "column_117",
+	Column118:         "column_118",
+	Column119:         "column_119",
+	Column120:         "column_120",
+	Column121:         "column_121",
+	Column122:         "column_122",
+	Column123:         "column_123",
+	Column124:         "column_124",
+	Column125:         "column_125",
+	Column126:         "column_126",
+	Column127:         "column_127",
+	Column128:         "column_128",
+	Column129:         "column_129",
+	Column130:         "column_130",
+	Column131:         "column_131",
+	Column132:         "column_132",
+	Column133:         "column_133",
+	Column134:         "column_134",
+	Column135:         "column_135",
+	Column136:         "column_136",
+	Column137:         "column_137",
+	Column138:         "column_138",
+	Column139:         "column_139",
+	Column140:         "column_140",
+	Column141:         "column_141",
+	Column142:         "column_142",
+	Column143:         "column_143",
+	Column144:         "column_144",
+	Column145:         "column_145",
+	Column146:         "column_146",
+	Column147:         "column_147",
+	Column148:         "column_148",
+	Column149:         "column_149",
+	Column150:         "column_150",
+	Column151:         "column_151",
+	Column152:         "column_152",
+	Column153:         "column_153",
+	Column154:         "column_154",
+	Column155:         "column_155",
+	Column156:         "column_156",
+	Column157:         "column_157",
+	Column158:         "column_158",
+	Column159:         "column_159",
+	Column160:         "column_160",
+	Column161:         "column_161",
+	Column162:         "column_162",
+	Column163:         "column_163",
+	Column164:         "column_164",
+	Column165:         "column_165",
+	Column166:         "column_166",
+	Column167:         "column_167",
+	Column168:         "column_168",
+	Column169:         "column_169",
+	Column170:         "column_170",
+	Column171:         "column_171",
+	Column172:         "column_172",
+	Column173:         "column_173",
+	Column174:         "column_174",
+	Column175:         "column_175",
+	Column176:         "column_176",
+	Column177:         "column_177",
+	Column178:         "column_178",
+	Column179:         "column_179",
+	Column180:         "column_180",
+	Column181:         "column_181",
+	Column182:         "column_182",
+	Column183:         "column_183",
+	Column184:         "column_184",
+	Column185:         "column_185",
+	Column186:         "column_186",
+	Column187:         "column_187",
+	Column188:         "column_188",
+	Column189:         "column_189",
+	Column190:         "column_190",
+	Column191:         "column_191",
+	Column192:         "column_192",
+	Column193:         "column_193",
+	Column194:         "column_194",
+	Column195:         "column_195",
+	Column196:         "column_196",
+	Column197:         "column_197",
+	Column198:         "column_198",
+	Column199:         "column_199",
+	Column200:         "column_200",
+	AllJSONFieldNames: []string{"column_001", "column_002", "column_003", "column_004", "column_005", "column_006", "column_007", "column_008", "column_009", "column_010", "column_011", "column_012", "column_013", "column_014", "column_015", "column_016", "column_017", "column_018", "column_019", "column_020", "column_021", "column_022", "column_023", "column_024", "column_025", "column_026", "column_027", "column_028", "column_029", "column_030", "column_031", "column_032", "column_033", "column_034", "column_035", "column_036", "column_037", "column_038", "column_039", "column_040", "column_041", "column_042", "column_043", "column_044", "column_045", "column_046", "column_047", "column_048", "column_049", "column_050", "column_051", "column_052", "column_053", "column_054", "column_055", "column_056", "column_057", "column_058", "column_059", "column_060", "column_061", "column_062", "column_063", "column_064", "column_065", "column_066", "column_067", "column_068", "column_069", "column_070", "column_071", "column_072", "column_073", "column_074", "column_075", "column_076", "column_077", "column_078", "column_079", "column_080", "column_081", "column_082", "column_083", "column_084", "column_085", "column_086", "column_087", "column_088", "column_089", "column_090", "column_091", "column_092", "column_093", "column_094", "column_095", "column_096", "column_097", "column_098", "column_099", "column_100", "column_101", "column_102", "column_103", "column_104", "column_105", "column_106", "column_107", "column_108", "column_109", "column_110", "column_111", "column_112", "column_113", "column_114", "column_115", "column_116", "column_117", "column_118", "column_119", "column_120", "column_121", "column_122", "column_123", "column_124", "column_125", "column_126", "column_127", "column_128", "column_129", "column_130", "column_131", "column_132", "column_133", "column_134", "column_135", "column_136", "column_137", "column_138", "column_139", "column_140", "column_141", "column_142", "column_143", "column_144", "column_145", "column_146", "column_147", "column_148", "column_149", "column_150", "column_151", "column_152", "column_153", "column_154", "column_155", "column_156", "column_157", "column_158", "column_159", "column_160", "column_161", "column_162", "column_163", "column_164", "column_165", "column_166", "column_167", "column_168", "column_169", "column_170", "column_171", "column_172", "column_173", "column_174", "column_175", "column_176", "column_177", "column_178", "column_179", "column_180", "column_181", "column_182", "column_183", "column_184", "column_185", "column_186", "column_187", "column_188", "column_189", "column_190", "column_191", "column_192", "column_193", "column_194", "column_195", "column_196", "column_197", "column_198", "column_199", "column_200"},
+	AllGoFieldNames:   []string{"Column001", "Column002", "Column003", "Column004", "Column005", "Column006", "Column007", "Column008", "Column009", "Column010", "Column011", "Column012", "Column013", "Column014", "Column015", "Column016", "Column017", "Column018", "Column019", "Column020", "Column021", "Column022", "Column023", "Column024", "Column025", "Column026", "Column027", "Column028", "Column029", "Column030", "Column031", "Column032", "Column033", "Column034", "Column035", "Column036", "Column037", "Column038", "Column039", "Column040", "Column041", "Column042", "Column043", "Column044", "Column045", "Column046", "Column047", "Column048", "Column049", "Column050", "Column051", "Column052", "Column053", "Column054", "Column055", "Column056", "Column057", "Column058", "Column059", "Column060", "Column061", "Column062", "Column063", "Column064", "Column065", "Column066", "Column067", "Column068", "Column069", "Column070", "Column071", "Column072", "Column073", "Column074", "Column075", "Column076", "Column077", "Column078", "Column079", "Column080", "Column081", "Column082", "Column083", "Column084", "Column085", "Column086", "Column087", "Column088", "Column089", "Column090", "Column091", "Column092", "Column093", "Column094", "Column095", "Column096", "Column097", "Column098", "Column099", "Column100", "Column101", "Column102", "Column103", "Column104", "Column105", "Column106", "Column107", "Column108", "Column109", "Column110", "Column111", "Column112", "Column113", "Column114", "Column115", "Column116", "Column117", "Column118", "Column119", "Column120", "Column121", "Column122", "Column123", "Column124", "Column125", "Column126", "Column127", "Column128", "Column129", "Column130", "Column131", "Column132", "Column133", "Column134", "Column135", "Column136", "Column137", "Column138", "Column139", "Column140", "Column141", "Column142", "Column143", "Column144", "Column145", "Column146", "Column147", "Column148", "Column149", "Column150", "Column151", "Column152", "Column153", "Column154", "Column155", "Column156", "Column157", "Column158", "Column159", "Column160", "Column161", "Column162", "Column163", "Column164", "Column165", "Column166", "Column167", "Column168", "Column169", "Column170", "Column171", "Column172", "Column173", "Column174", "Column175", "Column176", "Column177", "Column178", "Column179", "Column180", "Column181", "Column182", "Column183", "Column184", "Column185", "Column186", "Column187", "Column188", "Column189", "Column190", "Column191", "Column192", "Column193", "Column194", "Column195", "Column196", "Column197", "Column198", "Column199", "Column200"},
+}
+
+func IsValidWide_JSONField(f string) bool {
+	switch f {
+	case "column_001", "column_002", "column_003", "column_004", "column_005", "column_006", "column_007", "column_008", "column_009", "column_010", "column_011", "column_012", "column_013", "column_014", "column_015", "column_016", "column_017", "column_018", "column_019", "column_020", "column_021", "column_022", "column_023", "column_024", "column_025", "column_026", "column_027", "column_028", "column_029", "column_030", "column_031", "column_032", "column_033", "column_034", "column_035", "column_036", "column_037", "column_038", "column_039", "column_040", "column_041", "column_042", "column_043", "column_044", "column_045", "column_046", "column_047", "column_048", "column_049", "column_050", "column_051", "column_052", "column_053", "column_054", "column_055", "column_056", "column_057", "column_058", "column_059", "column_060", "column_061", "column_062", "column_063", "column_064", "column_065", "column_066", "column_067", "column_068", "column_069", "column_070", "column_071", "column_072", "column_073", "column_074", "column_075", "column_076", "column_077", "column_078", "column_079", "column_080", "column_081", "column_082", "column_083", "column_084", "column_085", "column_086", "column_087", "column_088", "column_089", "column_090", "column_091", "column_092", "column_093", "column_094", "column_095", "column_096", "column_097", "column_098", "column_099", "column_100", "column_101", "column_102", "column_103", "column_104", "column_105", "column_106", "column_107", "column_108", "column_109", "column_110", "column_111", "column_112", "column_113", "column_114", "column_115", "column_116", "column_117", "column_118", "column_119", "column_120", "column_121", "column_122", "column_123", "column_124", "column_125", "column_126", "column_127", "column_128", "column_129", "column_130", "column_131", "column_132", "column_133", "column_134", "column_135", "column_136", "column_137", "column_138", "column_139", "column_140", "column_141", "column_142", "column_143", "column_144", "column_145", "column_146", "column_147", "column_148", "column_149", "column_150", "column_151", "column_152", "column_153", "column_154", "column_155", "column_156", "column_157", "column_158", "column_159", "column_160", "column_161", "column_162", "column_163", "column_164", "column_165", "column_166", "column_167", "column_168", "column_169", "column_170", "column_171", "column_172", "column_173", "column_174", "column_175", "column_176", "column_177", "column_178", "column_179", "column_180", "column_181", "column_182", "column_183", "column_184", "column_185", "column_186", "column_187", "column_188", "column_189", "column_190", "column_191", "column_192", "column_193", "column_194", "column_195", "column_196", "column_197", "column_198", "column_199", "column_200":
+		return true
+	}
+	return false
+}
+
+// Wide_JSONGoNameFor returns the Go name of the field of Wide having the json name tagName, if there is one.
+func Wide_JSONGoNameFor(tagName string) (string, bool) {
+	switch tagName {
+	case "column_001":
+		return "Column001", true
+	case "column_002":
+		return "Column002", true
+	case "column_003":
+		return "Column003", true
+	case "column_004":
+		return "Column004", true
+	case "column_005":
+		return "Column005", true
+	case "column_006":
+		return "Column006", true
+	case "column_007":
+		return "Column007", true
+	case "column_008":
+		return "Column008", true
+	case "column_009":
+		return "Column009", true
+	case "column_010":
+		return "Column010", true
+	case "column_011":
+		return "Column011", true
+	case "column_012":
+		return "Column012", true
+	case "column_013":
+		return "Column013", true
+	case "column_014":
+		return "Column014", true
+	case "column_015":
+		return "Column015", true
+	case "column_016":
+		return "Column016", true
+	case "column_017":
+		return "Column017", true
+	case "column_018":
+		return "Column018", true
+	case "column_019":
+		return "Column019", true
+	case "column_020":
+		return "Column020", true
+	case "column_021":
+		return "Column021", true
+	case "column_022":
+		return "Column022", true
+	case "column_023":
+		return "Column023", true
+	case "column_024":
+		return "Column024", true
+	case "column_025":
+		return "Column025", true
+	case "column_026":
+		return "Column026", true
+	case "column_027":
+		return "Column027", true
+	case "column_028":
+		return "Column028", true
+	case "column_029":
+		return "Column029", true
+	case "column_030":
+		return "Column030", true
+	case "column_031":
+		return "Column031", true
+	case "column_032":
+		return "Column032", true
+	case "column_033":
+		return "Column033", true
+	case "column_034":
+		return "Column034", true
+	case "column_035":
+		return "Column035", true
+	case "column_036":
+		return "Column036", true
+	case "column_037":
+		return "Column037", true
+	case "column_038":
+		return "Column038", true
+	case "column_039":
+		return "Column039", true
+	case "column_040":
+		return "Column040", true
+	case "column_041":
+		return "Column041", true
+	case "column_042":
+		return "Column042", true
+	case "column_043":
+		return "Column043", true
+	case "column_044":
+		return "Column044", true
+	case "column_045":
+		return "Column045", true
+	case "column_046":
+		return "Column046", true
+	case "column_047":
+		return "Column047", true
+	case "column_048":
+		return "Column048", true
+	case "column_049":
+		return "Column049", true
+	case "column_050":
+		return "Column050", true
+	case "column_051":
+		return "Column051", true
+	case "column_052":
+		return "Column052", true
+	case "column_053":
+		return "Column053", true
+	case "column_054":
+		return "Column054", true
+	case "column_055":
+		return "Column055", true
+	case "column_056":
+		return "Column056", true
+	case "column_057":
+		return "Column057", true
+	case "column_058":
+		return "Column058", true
+	case "column_059":
+		return "Column059", true
+	case "column_060":
+		return "Column060", true
+	case "column_061":
+		return "Column061", true
+	case "column_062":
+		return "Column062", true
+	case "column_063":
+		return "Column063", true
+	case "column_064":
+		return "Column064", true
+	case "column_065":
+		return "Column065", true
+	case "column_066":
+		return "Column066", true
+	case "column_067":
+		return "Column067", true
+	case "column_068":
+		return "Column068", true
+	case "column_069":
+		return "Column069", true
+	case "column_070":
+		return "Column070", true
+	case "column_071":
+		return "Column071", true
+	case "column_072":
+		return "Column072", true
+	case "column_073":
+		return "Column073", true
+	case "column_074":
+		return "Column074", true
+	case "column_075":
+		return "Column075", true
+	case "column_076":
+		return "Column076", true
+	case "column_077":
+		return "Column077", true
+	case "column_078":
+		return "Column078", true
+	case "column_079":
+		return "Column079", true
+	case "column_080":
+		return "Column080", true
+	case "column_081":
+		return "Column081", true
+	case "column_082":
+		return "Column082", true
+	case "column_083":
+		return "Column083", true
+	case "column_084":
+		return "Column084", true
+	case "column_085":
+		return "Column085", true
+	case "column_086":
+		return "Column086", true
+	case "column_087":
+		return "Column087", true
+	case "column_088":
+		return "Column088", true
+	case "column_089":
+		return "Column089", true
+	case "column_090":
+		return "Column090", true
+	case "column_091":
+		return "Column091", true
+	case "column_092":
+		return "Column092", true
+	case "column_093":
+		return "Column093", true
+	case "column_094":
+		return "Column094", true
+	case "column_095":
+		return "Column095", true
+	case "column_096":
+		return "Column096", true
+	case "column_097":
+		return "Column097", true
+	case "column_098":
+		return "Column098", true
+	case "column_099":
+		return "Column099", true
+	case "column_100":
+		return "Column100", true
+	case "column_101":
+		return "Column101", true
+	case "column_102":
+		return "Column102", true
+	case "column_103":
+		return "Column103", true
+	case "column_104":
+		return "Column104", true
+	case "column_105":
+		return "Column105", true
+	case "column_106":
+		return "Column106", true
+	case "column_107":
+		return "Column107", true
+	case "column_108":
+		return "Column108", true
+	case "column_109":
+		return "Column109", true
+	case "column_110":
+		return "Column110", true
+	case "column_111":
+		return "Column111", true
+	case "column_112":
+		return "Column112", true
+	case "column_113":
+		return "Column113", true
+	case "column_114":
+		return "Column114", true
+	case "column_115":
+		return "Column115", true
+	case "column_116":
+		return "Column116", true
+	case "column_117":
+		return "Column117", true
+	case "column_118":
+		return "Column118", true
+	case "column_119":
+		return "Column119", true
+	case "column_120":
+		return "Column120", true
+	case "column_121":
+		return "Column121", true
+	case "column_122":
+		return "Column122", true
+	case "column_123":
+		return "Column123", true
+	case "column_124":
+		return "Column124", true
+	case "column_125":
+		return "Column125", true
+	case "column_126":
+		return "Column126", true
+	case "column_127":
+		return "Column127", true
+	case "column_128":
+		return "Column128", true
+	case "column_129":
+		return "Column129", true
+	case "column_130":
+		return "Column130", true
+	case "column_131":
+		return "Column131", true
+	case "column_132":
+		return "Column132", true
+	case "column_133":
+		return "Column133", true
+	case "column_134":
+		return "Column134", true
+	case "column_135":
+		return "Column135", true
+	case "column_136":
+		return "Column136", true
+	case "column_137":
+		return "Column137", true
+	case "column_138":
+		return "Column138", true
+	case "column_139":
+		return "Column139", true
+	case "column_140":
+		return "Column140", true
+	case "column_141":
+		return "Column141", true
+	case "column_142":
+		return "Column142", true
+	case "column_143":
+		return "Column143", true
+	case "column_144":
+		return "Column144", true
+	case "column_145":
+		return "Column145", true
+	case "column_146":
+		return "Column146", true
+	case "column_147":
+		return "Column147", true
+	case "column_148":
+		return "Column148", true
+	case "column_149":
+		return "Column149", true
+	case "column_150":
+		return "Column150", true
+	case "column_151":
+		return "Column151", true
+	case "column_152":
+		return "Column152", true
+	case "column_153":
+		return "Column153", true
+	case "column_154":
+		return "Column154", true
+	case "column_155":
+		return "Column155", true
+	case "column_156":
+		return "Column156", true
+	case "column_157":
+		return "Column157", true
+	case "column_158":
+		return "Column158", true
+	case "column_159":
+		return "Column159", true
+	case "column_160":
+		return "Column160", true
+	case "column_161":
+		return "Column161", true
+	case "column_162":
+		return "Column162", true
+	case "column_163":
+		return "Column163", true
+	case "column_164":
+		return "Column164", true
+	case "column_165":
+		return "Column165", true
+	case "column_166":
+		return "Column166", true
+	case "column_167":
+		return "Column167", true
+	case "column_168":
+		return "Column168", true
+	case "column_169":
+		return "Column169", true
+	case "column_170":
+		return "Column170", true
+	case "column_171":
+		return "Column171", true
+	case "column_172":
+		return "Column172", true
+	case "column_173":
+		return "Column173", true
+	case "column_174":
+		return "Column174", true
+	case "column_175":
+		return "Column175", true
+	case "column_176":
+		return "Column176", true
+	case "column_177":
+		return "Column177", true
+	case "column_178":
+		return "Column178", true
+	case "column_179":
+		return "Column179", true
+	case "column_180":
+		return "Column180", true
+	case "column_181":
+		return "Column181", true
+	case "column_182":
+		return "Column182", true
+	case "column_183":
+		return "Column183", true
+	case "column_184":
+		return "Column184", true
+	case "column_185":
+		return "Column185", true
+	case "column_186":
+		return "Column186", true
+	case "column_187":
+		return "Column187", true
+	case "column_188":
+		return "Column188", true
+	case "column_189":
+		return "Column189", true
+	case "column_190":
+		return "Column190", true
+	case "column_191":
+		return "Column191", true
+	case "column_192":
+		return "Column192", true
+	case "column_193":
+		return "Column193", true
+	case "column_194":
+		return "Column194", true
+	case "column_195":
+		return "Column195", true
+	case "column_196":
+		return "Column196", true
+	case "column_197":
+		return "Column197", true
+	case "column_198":
+		return "Column198", true
+	case "column_199":
+		return "Column199", true
+	case "column_200":
+		return "Column200", true
+	}
+	return "", false
+}
+
+// Wide_JSONTagNameFor returns the json name of the field of Wide having the Go name goName, if there is one.
+func Wide_JSONTagNameFor(goName string) (string, bool) {
+	switch goName {
+	case "Column001":
+		return "column_001", true
+	case "Column002":
+		return "column_002", true
+	case "Column003":
+		return "column_003", true
+	case "Column004":
+		return "column_004", true
+	case "Column005":
+		return "column_005", true
+	case "Column006":
+		return "column_006", true
+	case "Column007":
+		return "column_007", true
+	case "Column008":
+		return "column_008", true
+	case "Column009":
+		return "column_009", true
+	case "Column010":
+		return "column_010", true
+	case "Column011":
+		return "column_011", true
+	case "Column012":
+		return "column_012", true
+	case "Column013":
+		return "column_013", true
+	case "Column014":
+		return "column_014", true
+	case "Column015":
+		return "column_015", true
+	case "Column016":
+		return "column_016", true
+	case "Column017":
+		return "column_017", true
+	case "Column018":
+		return "column_018", true
+	case "Column019":
+		return "column_019", true
+	case "Column020":
+		return "column_020", true
+	case "Column021":
+		return "column_021", true
+	case "Column022":
+		return "column_022", true
+	case "Column023":
+		return "column_023", true
+	case "Column024":
+		return "column_024", true
+	case "Column025":
+		return "column_025", true
+	case "Column026":
+		return "column_026", true
+	case "Column027":
+		return "column_027", true
+	case "Column028":
+		return "column_028", true
+	case "Column029":
+		return "column_029", true
+	case "Column030":
+		return "column_030", true
+	case "Column031":
+		return "column_031", true
+	case "Column032":
+		return "column_032", true
+	case "Column033":
+		return "column_033", true
+	case "Column034":
+		return "column_034", true
+	case "Column035":
+		return "column_035", true
+	case "Column036":
+		return "column_036", true
+	case "Column037":
+		return "column_037", true
+	case "Column038":
+		return "column_038", true
+	case "Column039":
+		return "column_039", true
+	case "Column040":
+		return "column_040", true
+	case "Column041":
+		return "column_041", true
+	case "Column042":
+		return "column_042", true
+	case "Column043":
+		return "column_043", true
+	case "Column044":
+		return "column_044", true
+	case "Column045":
+		return "column_045", true
+	case "Column046":
+		return "column_046", true
+	case "Column047":
+		return "column_047", true
+	case "Column048":
+		return "column_048", true
+	case "Column049":
+		return "column_049", true
+	case "Column050":
+		return "column_050", true
+	case "Column051":
+		return "column_051", true
+	case "Column052":
+		return "column_052", true
+	case "Column053":
+		return "column_053", true
+	case "Column054":
+		return "column_054", true
+	case "Column055":
+		return "column_055", true
+	case "Column056":
+		return "column_056", true
+	case "Column057":
+		return "column_057", true
+	case "Column058":
+		return "column_058", true
+	case "Column059":
+		return "column_059", true
+	case "Column060":
+		return "column_060", true
+	case "Column061":
+		return "column_061", true
+	case "Column062":
+		return "column_062", true
+	case "Column063":
+		return "column_063", true
+	case "Column064":
+		return "column_064", true
+	case "Column065":
+		return "column_065", true
+	case "Column066":
+		return "column_066", true
+	case "Column067":
+		return "column_067", true
+	case "Column068":
+		return "column_068", true
+	case "Column069":
+		return "column_069", true
+	case "Column070":
+		return "column_070", true
+	case "Column071":
+		return "column_071", true
+	case "Column072":
+		return "column_072", true
+	case "Column073":
+		return "column_073", true
+	case "Column074":
+		return "column_074", true
+	case "Column075":
+		return "column_075", true
+	case "Column076":
+		return "column_076", true
+	case "Column077":
+		return "column_077", true
+	case "Column078":
+		return "column_078", true
+	case "Column079":
+		return "column_079", true
+	case "Column080":
+		return "column_080", true
+	case "Column081":
+		return "column_081", true
+	case "Column082":
+		return "column_082", true
+	case "Column083":
+		return "column_083", true
+	case "Column084":
+		return "column_084", true
+	case "Column085":
+		return "column_085", true
+	case "Column086":
+		return "column_086", true
+	case "Column087":
+		return "column_087", true
+	case "Column088":
+		return "column_088", true
+	case "Column089":
+		return "column_089", true
+	case "Column090":
+		return "column_090", true
+	case "Column091":
+		return "column_091", true
+	case "Column092":
+		return "column_092", true
+	case "Column093":
+		return "column_093", true
+	case "Column094":
+		return "column_094", true
+	case "Column095":
+		return "column_095", true
+	case "Column096":
+		return "column_096", true
+	case "Column097":
+		return "column_097", true
+	case "Column098":
+		return "column_098", true
+	case "Column099":
+		return "column_099", true
+	case "Column100":
+		return "column_100", true
+	case "Column101":
+		return "column_101", true
+	case "Column102":
+		return "column_102", true
+	case "Column103":
+		return "column_103", true
+	case "Column104":
+		return "column_104", true
+	case "Column105":
+		return "column_105", true
+	case "Column106":
+		return "column_106", true
+	case "Column107":
+		return "column_107", true
+	case "Column108":
+		return "column_108", true
+	case "Column109":
+		return "column_109", true
+	case "Column110":
+		return "column_110", true
+	case "Column111":
+		return "column_111", true
+	case "Column112":
+		return "column_112", true
+	case "Column113":
+		return "column_113", true
+	case "Column114":
+		return "column_114", true
+	case "Column115":
+		return "column_115", true
+	case "Column116":
+		return "column_116", true
+	case "Column117":
+		return "column_117", true
+	case "Column118":
+		return "column_118", true
+	case "Column119":
+		return "column_119", true
+	case "Column120":
+		return "column_120", true
+	case "Column121":
+		return "column_121", true
+	case "Column122":
+		return "column_122", true
+	case "Column123":
+		return "column_123", true
+	case "Column124":
+		return "column_124", true
+	case "Column125":
+		return "column_125", true
+	case "Column126":
+		return "column_126", true
+	case "Column127":
+		return "column_127", true
+	case "Column128":
+		return "column_128", true
+	case "Column129":
+		return "column_129", true
+	case "Column130":
+		return "column_130", true
+	case "Column131":
+		return "column_131", true
+	case "Column132":
+		return "column_132", true
+	case "Column133":
+		return "column_133", true
+	case "Column134":
+		return "column_134", true
+	case "Column135":
+		return "column_135", true
+	case "Column136":
+		return "column_136", true
+	case "Column137":
+		return "column_137", true
+	case "Column138":
+		return "column_138", true
+	case "Column139":
+		return "column_139", true
+	case "Column140":
+		return "column_140", true
+	case "Column141":
+		return "column_141", true
+	case "Column142":
+		return "column_142", true
+	case "Column143":
+		return "column_143", true
+	case "Column144":
+		return "column_144", true
+	case "Column145":
+		return "column_145", true
+	case "Column146":
+		return "column_146", true
+	case "Column147":
+		return "column_147", true
+	case "Column148":
+		return "column_148", true
+	case "Column149":
+		return "column_149", true
+	case "Column150":
+		return "column_150", true
+	case "Column151":
+		return "column_151", true
+	case "Column152":
+		return "column_152", true
+	case "Column153":
+		return "column_153", true
+	case "Column154":
+		return "column_154", true
+	case "Column155":
+		return "column_155", true
+	case "Column156":
+		return "column_156", true
+	case "Column157":
+		return "column_157", true
+	case "Column158":
+		return "column_158", true
+	case "Column159":
+		return "column_159", true
+	case "Column160":
+		return "column_160", true
+	case "Column161":
+		return "column_161", true
+	case "Column162":
+		return "column_162", true
+	case "Column163":
+		return "column_163", true
+	case "Column164":
+		return "column_164", true
+	case "Column165":
+		return "column_165", true
+	case "Column166":
+		return "column_166", true
+	case "Column167":
+		return "column_167", true
+	case "Column168":
+		return "column_168", true
+	case "Column169":
+		return "column_169", true
+	case "Column170":
+		return "column_170", true
+	case "Column171":
+		return "column_171", true
+	case "Column172":
+		return "column_172", true
+	case "Column173":
+		return "column_173", true
+	case "Column174":
+		return "column_174", true
+	case "Column175":
+		return "column_175", true
+	case "Column176":
+		return "column_176", true
+	case "Column177":
+		return "column_177", true
+	case "Column178":
+		return "column_178", true
+	case "Column179":
+		return "column_179", true
+	case "Column180":
+		return "column_180", true
+	case "Column181":
+		return "column_181", true
+	case "Column182":
+		return "column_182", true
+	case "Column183":
+		return "column_183", true
+	case "Column184":
+		return "column_184", true
+	case "Column185":
+		return "column_185", true
+	case "Column186":
+		return "column_186", true
+	case "Column187":
+		return "column_187", true
+	case "Column188":
+		return "column_188", true
+	case "Column189":
+		return "column_189", true
+	case "Column190":
+		return "column_190", true
+	case "Column191":
+		return "column_191", true
+	case "Column192":
+		return "column_192", true
+	case "Column193":
+		return "column_193", true
+	case "Column194":
+		return "column_194", true
+	case "Column195":
+		return "column_195", true
+	case "Column196":
+		return "column_196", true
+	case "Column197":
+		return "column_197", true
+	case "Column198":
+		return "column_198", true
+	case "Column199":
+		return "column_199", true
+	case "Column200":
+		return "column_200", true
+	}
+	return "", false
+}
+
+var Wide_JSON_Fields = []struct {
+	Name     string
+	GoName   string
+	GoType   string
+	Kind     string
+	Nullable bool
+	PkgPath  string
+}{
+	{Name: "column_001", GoName: "Column001", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_002", GoName: "Column002", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_003", GoName: "Column003", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_004", GoName: "Column004", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_005", GoName: "Column005", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_006", GoName: "Column006", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_007", GoName: "Column007", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_008", GoName: "Column008", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_009", GoName: "Column009", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_010", GoName: "Column010", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_011", GoName: "Column011", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_012", GoName: "Column012", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_013", GoName: "Column013", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_014", GoName: "Column014", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_015", GoName: "Column015", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_016", GoName: "Column016", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_017", GoName: "Column017", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_018", GoName: "Column018", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_019", GoName: "Column019", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_020", GoName: "Column020", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_021", GoName: "Column021", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_022", GoName: "Column022", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_023", GoName: "Column023", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_024", GoName: "Column024", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_025", GoName: "Column025", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_026", GoName: "Column026", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_027", GoName: "Column027", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_028", GoName: "Column028", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_029", GoName: "Column029", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_030", GoName: "Column030", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_031", GoName: "Column031", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_032", GoName: "Column032", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_033", GoName: "Column033", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_034", GoName: "Column034", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_035", GoName: "Column035", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_036", GoName: "Column036", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_037", GoName: "Column037", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_038", GoName: "Column038", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_039", GoName: "Column039", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_040", GoName: "Column040", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_041", GoName: "Column041", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_042", GoName: "Column042", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_043", GoName: "Column043", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_044", GoName: "Column044", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_045", GoName: "Column045", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_046", GoName: "Column046", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_047", GoName: "Column047", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_048", GoName: "Column048", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_049", GoName: "Column049", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_050", GoName: "Column050", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_051", GoName: "Column051", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_052", GoName: "Column052", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_053", GoName: "Column053", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_054", GoName: "Column054", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_055", GoName: "Column055", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_056", GoName: "Column056", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_057", GoName: "Column057", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_058", GoName: "Column058", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_059", GoName: "Column059", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_060", GoName: "Column060", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_061", GoName: "Column061", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_062", GoName: "Column062", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_063", GoName: "Column063", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_064", GoName: "Column064", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_065", GoName: "Column065", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_066", GoName: "Column066", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_067", GoName: "Column067", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_068", GoName: "Column068", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_069", GoName: "Column069", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_070", GoName: "Column070", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_071", GoName: "Column071", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_072", GoName: "Column072", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_073", GoName: "Column073", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_074", GoName: "Column074", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_075", GoName: "Column075", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_076", GoName: "Column076", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_077", GoName: "Column077", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_078", GoName: "Column078", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_079", GoName: "Column079", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_080", GoName: "Column080", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_081", GoName: "Column081", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_082", GoName: "Column082", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_083", GoName: "Column083", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_084", GoName: "Column084", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_085", GoName: "Column085", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_086", GoName: "Column086", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_087", GoName: "Column087", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_088", GoName: "Column088", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_089", GoName: "Column089", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_090", GoName: "Column090", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_091", GoName: "Column091", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_092", GoName: "Column092", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_093", GoName: "Column093", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_094", GoName: "Column094", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_095", GoName: "Column095", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_096", GoName: "Column096", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_097", GoName: "Column097", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_098", GoName: "Column098", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_099", GoName: "Column099", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_100", GoName: "Column100", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_101", GoName: "Column101", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_102", GoName: "Column102", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_103", GoName: "Column103", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_104", GoName: "Column104", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_105", GoName: "Column105", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_106", GoName: "Column106", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_107", GoName: "Column107", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_108", GoName: "Column108", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_109", GoName: "Column109", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_110", GoName: "Column110", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_111", GoName: "Column111", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_112", GoName: "Column112", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_113", GoName: "Column113", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_114", GoName: "Column114", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_115", GoName: "Column115", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_116", GoName: "Column116", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_117", GoName: "Column117", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_118", GoName: "Column118", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_119", GoName: "Column119", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_120", GoName: "Column120", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_121", GoName: "Column121", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_122", GoName: "Column122", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_123", GoName: "Column123", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_124", GoName: "Column124", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_125", GoName: "Column125", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_126", GoName: "Column126", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_127", GoName: "Column127", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_128", GoName: "Column128", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_129", GoName: "Column129", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_130", GoName: "Column130", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_131", GoName: "Column131", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_132", GoName: "Column132", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_133", GoName: "Column133", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_134", GoName: "Column134", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_135", GoName: "Column135", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_136", GoName: "Column136", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_137", GoName: "Column137", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_138", GoName: "Column138", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_139", GoName: "Column139", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_140", GoName: "Column140", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_141", GoName: "Column141", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_142", GoName: "Column142", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_143", GoName: "Column143", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_144", GoName: "Column144", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_145", GoName: "Column145", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_146", GoName: "Column146", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_147", GoName: "Column147", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_148", GoName: "Column148", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_149", GoName: "Column149", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_150", GoName: "Column150", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_151", GoName: "Column151", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_152", GoName: "Column152", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_153", GoName: "Column153", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_154", GoName: "Column154", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_155", GoName: "Column155", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_156", GoName: "Column156", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_157", GoName: "Column157", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_158", GoName: "Column158", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_159", GoName: "Column159", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_160", GoName: "Column160", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_161", GoName: "Column161", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_162", GoName: "Column162", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_163", GoName: "Column163", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_164", GoName: "Column164", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_165", GoName: "Column165", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_166", GoName: "Column166", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_167", GoName: "Column167", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_168", GoName: "Column168", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_169", GoName: "Column169", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_170", GoName: "Column170", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_171", GoName: "Column171", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_172", GoName: "Column172", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_173", GoName: "Column173", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_174", GoName: "Column174", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_175", GoName: "Column175", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_176", GoName: "Column176", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_177", GoName: "Column177", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_178", GoName: "Column178", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_179", GoName: "Column179", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_180", GoName: "Column180", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_181", GoName: "Column181", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_182", GoName: "Column182", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_183", GoName: "Column183", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_184", GoName: "Column184", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_185", GoName: "Column185", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_186", GoName: "Column186", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_187", GoName: "Column187", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_188", GoName: "Column188", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_189", GoName: "Column189", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_190", GoName: "Column190", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_191", GoName: "Column191", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_192", GoName: "Column192", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_193", GoName: "Column193", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_194", GoName: "Column194", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_195", GoName: "Column195", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_196", GoName: "Column196", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_197", GoName: "Column197", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_198", GoName: "Column198", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_199", GoName: "Column199", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+	{Name: "column_200", GoName: "Column200", GoType: "string", Kind: "basic", Nullable: false, PkgPath: ""},
+}
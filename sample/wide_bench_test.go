@@ -0,0 +1,39 @@
+package sample
+
+import "testing"
+
+// isValidWideByScan is IsValidWide_JSONField as generated before it switched, scanning every name
+func isValidWideByScan(f string) bool {
+	for _, name := range Wide_JSON.AllJSONFieldNames {
+		if name == f {
+			return true
+		}
+	}
+	return false
+}
+
+// the last column is the worst case for a scan, and a missing one is what filters reject
+var wideLookups = []string{"column_001", "column_100", "column_200", "missing"}
+
+func TestIsValidWideField(t *testing.T) {
+	for _, f := range append(wideLookups, Wide_JSON.AllJSONFieldNames...) {
+		if got, want := IsValidWide_JSONField(f), isValidWideByScan(f); got != want {
+			t.Errorf("IsValidWide_JSONField(%q) = %t, want %t", f, got, want)
+		}
+	}
+}
+
+func BenchmarkIsValidWideField(b *testing.B) {
+	for _, f := range wideLookups {
+		b.Run("scan/"+f, func(b *testing.B) {
+			for b.Loop() {
+				isValidWideByScan(f)
+			}
+		})
+		b.Run("switch/"+f, func(b *testing.B) {
+			for b.Loop() {
+				IsValidWide_JSONField(f)
+			}
+		})
+	}
+}
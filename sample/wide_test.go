@@ -0,0 +1,205 @@
+package sample
+
+// Wide is a table about as wide as those IsValid is meant for, having 200 columns
+type Wide struct {
+	Column001 string `json:"column_001"`
+	Column002 string `json:"column_002"`
+	Column003 string `json:"column_003"`
+	Column004 string `json:"column_004"`
+	Column005 string `json:"column_005"`
+	Column006 string `json:"column_006"`
+	Column007 string `json:"column_007"`
+	Column008 string `json:"column_008"`
+	Column009 string `json:"column_009"`
+	Column010 string `json:"column_010"`
+	Column011 string `json:"column_011"`
+	Column012 string `json:"column_012"`
+	Column013 string `json:"column_013"`
+	Column014 string `json:"column_014"`
+	Column015 string `json:"column_015"`
+	Column016 string `json:"column_016"`
+	Column017 string `json:"column_017"`
+	Column018 string `json:"column_018"`
+	Column019 string `json:"column_019"`
+	Column020 string `json:"column_020"`
+	Column021 string `json:"column_021"`
+	Column022 string `json:"column_022"`
+	Column023 string `json:"column_023"`
+	Column024 string `json:"column_024"`
+	Column025 string `json:"column_025"`
+	Column026 string `json:"column_026"`
+	Column027 string `json:"column_027"`
+	Column028 string `json:"column_028"`
+	Column029 string `json:"column_029"`
+	Column030 string `json:"column_030"`
+	Column031 string `json:"column_031"`
+	Column032 string `json:"column_032"`
+	Column033 string `json:"column_033"`
+	Column034 string `json:"column_034"`
+	Column035 string `json:"column_035"`
+	Column036 string `json:"column_036"`
+	Column037 string `json:"column_037"`
+	Column038 string `json:"column_038"`
+	Column039 string `json:"column_039"`
+	Column040 string `json:"column_040"`
+	Column041 string `json:"column_041"`
+	Column042 string `json:"column_042"`
+	Column043 string `json:"column_043"`
+	Column044 string `json:"column_044"`
+	Column045 string `json:"column_045"`
+	Column046 string `json:"column_046"`
+	Column047 string `json:"column_047"`
+	Column048 string `json:"column_048"`
+	Column049 string `json:"column_049"`
+	Column050 string `json:"column_050"`
+	Column051 string `json:"column_051"`
+	Column052 string `json:"column_052"`
+	Column053 string `json:"column_053"`
+	Column054 string `json:"column_054"`
+	Column055 string `json:"column_055"`
+	Column056 string `json:"column_056"`
+	Column057 string `json:"column_057"`
+	Column058 string `json:"column_058"`
+	Column059 string `json:"column_059"`
+	Column060 string `json:"column_060"`
+	Column061 string `json:"column_061"`
+	Column062 string `json:"column_062"`
+	Column063 string `json:"column_063"`
+	Column064 string `json:"column_064"`
+	Column065 string `json:"column_065"`
+	Column066 string `json:"column_066"`
+	Column067 string `json:"column_067"`
+	Column068 string `json:"column_068"`
+	Column069 string `json:"column_069"`
+	Column070 string `json:"column_070"`
+	Column071 string `json:"column_071"`
+	Column072 string `json:"column_072"`
+	Column073 string `json:"column_073"`
+	Column074 string `json:"column_074"`
+	Column075 string `json:"column_075"`
+	Column076 string `json:"column_076"`
+	Column077 string `json:"column_077"`
+	Column078 string `json:"column_078"`
+	Column079 string `json:"column_079"`
+	Column080 string `json:"column_080"`
+	Column081 string `json:"column_081"`
+	Column082 string `json:"column_082"`
+	Column083 string `json:"column_083"`
+	Column084 string `json:"column_084"`
+	Column085 string `json:"column_085"`
+	Column086 string `json:"column_086"`
+	Column087 string `json:"column_087"`
+	Column088 string `json:"column_088"`
+	Column089 string `json:"column_089"`
+	Column090 string `json:"column_090"`
+	Column091 string `json:"column_091"`
+	Column092 string `json:"column_092"`
+	Column093 string `json:"column_093"`
+	Column094 string `json:"column_094"`
+	Column095 string `json:"column_095"`
+	Column096 string `json:"column_096"`
+	Column097 string `json:"column_097"`
+	Column098 string `json:"column_098"`
+	Column099 string `json:"column_099"`
+	Column100 string `json:"column_100"`
+	Column101 string `json:"column_101"`
+	Column102 string `json:"column_102"`
+	Column103 string `json:"column_103"`
+	Column104 string `json:"column_104"`
+	Column105 string `json:"column_105"`
+	Column106 string `json:"column_106"`
+	Column107 string `json:"column_107"`
+	Column108 string `json:"column_108"`
+	Column109 string `json:"column_109"`
+	Column110 string `json:"column_110"`
+	Column111 string `json:"column_111"`
+	Column112 string `json:"column_112"`
+	Column113 string `json:"column_113"`
+	Column114 string `json:"column_114"`
+	Column115 string `json:"column_115"`
+	Column116 string `json:"column_116"`
+	Column117 string `json:"column_117"`
+	Column118 string `json:"column_118"`
+	Column119 string `json:"column_119"`
+	Column120 string `json:"column_120"`
+	Column121 string `json:"column_121"`
+	Column122 string `json:"column_122"`
+	Column123 string `json:"column_123"`
+	Column124 string `json:"column_124"`
+	Column125 string `json:"column_125"`
+	Column126 string `json:"column_126"`
+	Column127 string `json:"column_127"`
+	Column128 string `json:"column_128"`
+	Column129 string `json:"column_129"`
+	Column130 string `json:"column_130"`
+	Column131 string `json:"column_131"`
+	Column132 string `json:"column_132"`
+	Column133 string `json:"column_133"`
+	Column134 string `json:"column_134"`
+	Column135 string `json:"column_135"`
+	Column136 string `json:"column_136"`
+	Column137 string `json:"column_137"`
+	Column138 string `json:"column_138"`
+	Column139 string `json:"column_139"`
+	Column140 string `json:"column_140"`
+	Column141 string `json:"column_141"`
+	Column142 string `json:"column_142"`
+	Column143 string `json:"column_143"`
+	Column144 string `json:"column_144"`
+	Column145 string `json:"column_145"`
+	Column146 string `json:"column_146"`
+	Column147 string `json:"column_147"`
+	Column148 string `json:"column_148"`
+	Column149 string `json:"column_149"`
+	Column150 string `json:"column_150"`
+	Column151 string `json:"column_151"`
+	Column152 string `json:"column_152"`
+	Column153 string `json:"column_153"`
+	Column154 string `json:"column_154"`
+	Column155 string `json:"column_155"`
+	Column156 string `json:"column_156"`
+	Column157 string `json:"column_157"`
+	Column158 string `json:"column_158"`
+	Column159 string `json:"column_159"`
+	Column160 string `json:"column_160"`
+	Column161 string `json:"column_161"`
+	Column162 string `json:"column_162"`
+	Column163 string `json:"column_163"`
+	Column164 string `json:"column_164"`
+	Column165 string `json:"column_165"`
+	Column166 string `json:"column_166"`
+	Column167 string `json:"column_167"`
+	Column168 string `json:"column_168"`
+	Column169 string `json:"column_169"`
+	Column170 string `json:"column_170"`
+	Column171 string `json:"column_171"`
+	Column172 string `json:"column_172"`
+	Column173 string `json:"column_173"`
+	Column174 string `json:"column_174"`
+	Column175 string `json:"column_175"`
+	Column176 string `json:"column_176"`
+	Column177 string `json:"column_177"`
+	Column178 string `json:"column_178"`
+	Column179 string `json:"column_179"`
+	Column180 string `json:"column_180"`
+	Column181 string `json:"column_181"`
+	Column182 string `json:"column_182"`
+	Column183 string `json:"column_183"`
+	Column184 string `json:"column_184"`
+	Column185 string `json:"column_185"`
+	Column186 string `json:"column_186"`
+	Column187 string `json:"column_187"`
+	Column188 string `json:"column_188"`
+	Column189 string `json:"column_189"`
+	Column190 string `json:"column_190"`
+	Column191 string `json:"column_191"`
+	Column192 string `json:"column_192"`
+	Column193 string `json:"column_193"`
+	Column194 string `json:"column_194"`
+	Column195 string `json:"column_195"`
+	Column196 string `json:"column_196"`
+	Column197 string `json:"column_197"`
+	Column198 string `json:"column_198"`
+	Column199 string `json:"column_199"`
+	Column200 string `json:"column_200"`
+}
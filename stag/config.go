@@ -0,0 +1,351 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/bradleygore/go-stag/model"
+	"gopkg.in/yaml.v3"
+)
+
+// configFileNames are looked for at the module root, in order
+var configFileNames = []string{"stag.yaml", "stag.yml", ".stag.json"}
+
+// defaultFileNamePattern names generated files; {name} is the source file's name without .go, {pkg} its pkg name
+const defaultFileNamePattern = "{name}.stag-{tag}.go"
+
+// config is a project's stag.yaml or .stag.json. It holds the same settings as the cmd flags, which win
+// over it when given, along with per-dir overrides. Paths are relative to the config file's dir.
+type config struct {
+	dir string // dir of the config file
+
+	Tags         []string                `json:"tags" yaml:"tags"`
+	Sources      []string                `json:"sources" yaml:"sources"` // files, dirs or go patterns like ./...
+	Exclude      []string                `json:"exclude" yaml:"exclude"`
+	Types        []string                `json:"types" yaml:"types"`
+	ExcludeTypes []string                `json:"excludeTypes" yaml:"excludeTypes"`
+	Tests        *bool                   `json:"tests" yaml:"tests"`
+	Nested       *bool                   `json:"nested" yaml:"nested"`
+	Sep          *string                 `json:"sep" yaml:"sep"`
+	Inline       map[string]string       `json:"inline" yaml:"inline"`
+	SkipMarkers  []string                `json:"skipMarkers" yaml:"skipMarkers"` // tag values leaving a field out, in place of - and ignore
+	Output       outputConfig            `json:"output" yaml:"output"`
+	Idents       map[string]string       `json:"idents" yaml:"idents"`       // templates naming generated identifiers, by kind
+	Style        string                  `json:"style" yaml:"style"`         // var | const
+	Templates    []string                `json:"templates" yaml:"templates"` // built-in template names, template files or dirs
+	Policies     map[string]policyConfig `json:"policies" yaml:"policies"`   // by tag
+	Dirs         map[string]dirConfig    `json:"dirs" yaml:"dirs"`           // by dir; nested dirs override their parents
+}
+
+type outputConfig struct {
+	To       string `json:"to" yaml:"to"`             // file | stdout
+	FileName string `json:"fileName" yaml:"fileName"` // pattern of generated file names, i.e. {name}.stag-{tag}.go
+}
+
+// policyConfig overrides the settings it gives of a tag's policy, like the -policy flag
+type policyConfig struct {
+	Untagged   *bool  `json:"untagged" yaml:"untagged"`
+	Unexported *bool  `json:"unexported" yaml:"unexported"`
+	Naming     string `json:"naming" yaml:"naming"` // fallback naming of fields the tag gives no name
+
+	Idents map[string]string `json:"idents" yaml:"idents"` // templates naming the identifiers generated for the tag
+}
+
+// dirConfig overrides the config for the files in a dir and those beneath it
+type dirConfig struct {
+	Skip         bool                    `json:"skip" yaml:"skip"` // generate nothing for the dir
+	Tags         []string                `json:"tags" yaml:"tags"`
+	Types        []string                `json:"types" yaml:"types"`
+	ExcludeTypes []string                `json:"excludeTypes" yaml:"excludeTypes"`
+	Policies     map[string]policyConfig `json:"policies" yaml:"policies"`
+	FileName     string                  `json:"fileName" yaml:"fileName"`
+}
+
+// findConfig returns the path of the config file at the root of the module holding dir, if there's one
+func findConfig(dir string) string {
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			for _, name := range configFileNames {
+				if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+					return filepath.Join(dir, name)
+				}
+			}
+			return ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// loadConfig reads the config at path, or an empty one when path is empty. Unknown settings are errors, to catch typos.
+func loadConfig(path string) (*config, error) {
+	cfg := &config{}
+	if path == "" {
+		return cfg, nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(path, ".json") {
+		dec := json.NewDecoder(bytes.NewReader(content))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(cfg)
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(content))
+		dec.KnownFields(true)
+		// an empty file has nothing to decode
+		if err = dec.Decode(cfg); errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	if cfg.dir, err = filepath.Abs(filepath.Dir(path)); err != nil {
+		return nil, err
+	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("config %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+func (cfg *config) validate() error {
+	if err := validateFileName(cfg.Output.FileName); err != nil {
+		return err
+	}
+	if err := validatePolicies(cfg.Policies); err != nil {
+		return err
+	}
+	if _, err := parseIdentTemplates(nil, cfg.Idents); err != nil {
+		return err
+	}
+	for dir, dirCfg := range cfg.Dirs {
+		if err := validateFileName(dirCfg.FileName); err != nil {
+			return fmt.Errorf("dir %s: %w", dir, err)
+		}
+		if err := validatePolicies(dirCfg.Policies); err != nil {
+			return fmt.Errorf("dir %s: %w", dir, err)
+		}
+		if _, err := newTypeFilter(strings.Join(dirCfg.Types, ","), strings.Join(dirCfg.ExcludeTypes, ",")); err != nil {
+			return fmt.Errorf("dir %s: %w", dir, err)
+		}
+	}
+	return nil
+}
+
+// validateFileName makes sure generated files are recognized as such, so they're never processed as sources,
+// and that each source file has its own, as output is generated per source file
+func validateFileName(pattern string) error {
+	if pattern == "" {
+		return nil
+	}
+	if !strings.Contains(pattern, "{tag}") || strings.ContainsRune(pattern, '/') || !rxIsStagFile.MatchString(pattern) {
+		return fmt.Errorf("fileName %q must hold {tag} and end like .stag-{tag}.go, without any dir", pattern)
+	}
+	if !strings.Contains(pattern, "{name}") {
+		return fmt.Errorf("fileName %q must hold {name}, or the output of a pkg's files would overwrite each other", pattern)
+	}
+	return nil
+}
+
+func validatePolicies(policies map[string]policyConfig) error {
+	for tag, pc := range policies {
+		if _, err := pc.apply(model.PolicyFor(tag)); err != nil {
+			return fmt.Errorf("policy for tag %s: %w", tag, err)
+		}
+		if _, err := parseIdentTemplates(nil, pc.Idents); err != nil {
+			return fmt.Errorf("policy for tag %s: %w", tag, err)
+		}
+	}
+	return nil
+}
+
+// apply returns policy with the settings given by pc overridden
+func (pc policyConfig) apply(policy model.TagPolicy) (model.TagPolicy, error) {
+	if pc.Untagged != nil {
+		policy.Untagged = *pc.Untagged
+	}
+	if pc.Unexported != nil {
+		policy.Unexported = *pc.Unexported
+	}
+	if pc.Naming != "" {
+		if policy.Naming = model.Naming(pc.Naming); !policy.Naming.Valid() {
+			return policy, fmt.Errorf("unknown naming %q", pc.Naming)
+		}
+	}
+	return policy, nil
+}
+
+// applyFlags sets every flag not given on the cmd line from the config, so they're validated as if they had been
+func (cfg *config) applyFlags(given map[string]bool) error {
+	settings := map[string]string{}
+	if len(cfg.Tags) > 0 {
+		settings["tags"] = strings.Join(cfg.Tags, ",")
+	}
+	if len(cfg.Exclude) > 0 {
+		settings["exclude"] = strings.Join(cfg.Exclude, ",")
+	}
+	if len(cfg.Types) > 0 {
+		settings["types"] = strings.Join(cfg.Types, ",")
+	}
+	if len(cfg.ExcludeTypes) > 0 {
+		settings["excludetypes"] = strings.Join(cfg.ExcludeTypes, ",")
+	}
+	if cfg.Tests != nil {
+		settings["tests"] = strconv.FormatBool(*cfg.Tests)
+	}
+	if cfg.Nested != nil {
+		settings["nested"] = strconv.FormatBool(*cfg.Nested)
+	}
+	if cfg.Sep != nil {
+		settings["sep"] = *cfg.Sep
+	}
+	if len(cfg.Inline) > 0 {
+		pairs := []string{}
+		for tag, opt := range cfg.Inline {
+			pairs = append(pairs, tag+"="+opt)
+		}
+		sort.Strings(pairs)
+		settings["inline"] = strings.Join(pairs, ",")
+	}
+	if cfg.Output.To != "" {
+		settings["out"] = cfg.Output.To
+	}
+	if len(cfg.Sources) > 0 {
+		// sources are relative to the config, rather than to where stag runs
+		abs := []string{}
+		for _, source := range cfg.Sources {
+			if !filepath.IsAbs(source) && (source == "." || strings.HasPrefix(source, "./") || strings.HasPrefix(source, "../")) {
+				source = filepath.Join(cfg.dir, source)
+			}
+			abs = append(abs, source)
+		}
+		settings["source"] = strings.Join(abs, ",")
+	}
+	if cfg.Style != "" {
+		settings["style"] = cfg.Style
+	}
+	if len(cfg.Templates) > 0 {
+		builtins, err := builtinTemplateNames()
+		if err != nil {
+			return err
+		}
+		tmpls := []string{}
+		for _, tmpl := range cfg.Templates {
+			if _, builtin := builtins[tmpl]; !builtin && !filepath.IsAbs(tmpl) {
+				tmpl = filepath.Join(cfg.dir, tmpl)
+			}
+			tmpls = append(tmpls, tmpl)
+		}
+		settings["template"] = strings.Join(tmpls, ",")
+	}
+	for name, val := range settings {
+		if given[name] {
+			continue
+		}
+		if err := flag.Set(name, val); err != nil {
+			return fmt.Errorf("config setting for %s: %w", name, err)
+		}
+	}
+
+	if len(cfg.SkipMarkers) > 0 {
+		model.SkipMarkers = cfg.SkipMarkers
+	}
+	// policies are applied ahead of the -policy flag, which overrides them per setting
+	for tag, pc := range cfg.Policies {
+		policy, _ := pc.apply(model.PolicyFor(tag))
+		model.TagPolicies[tag] = policy
+	}
+	return nil
+}
+
+// forDir returns the overrides applying to the files in dir, merged from the outermost configured dir inwards
+func (cfg *config) forDir(dir string) dirConfig {
+	merged := dirConfig{}
+	dirs := []string{}
+	for d := range cfg.Dirs {
+		dirs = append(dirs, d)
+	}
+	sort.Slice(dirs, func(i, j int) bool {
+		return len(filepath.Clean(dirs[i])) < len(filepath.Clean(dirs[j]))
+	})
+	for _, d := range dirs {
+		rel, err := filepath.Rel(filepath.Join(cfg.dir, d), dir)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		dirCfg := cfg.Dirs[d]
+		merged.Skip = merged.Skip || dirCfg.Skip
+		if len(dirCfg.Tags) > 0 {
+			merged.Tags = dirCfg.Tags
+		}
+		if len(dirCfg.Types) > 0 || len(dirCfg.ExcludeTypes) > 0 {
+			merged.Types, merged.ExcludeTypes = dirCfg.Types, dirCfg.ExcludeTypes
+		}
+		if dirCfg.FileName != "" {
+			merged.FileName = dirCfg.FileName
+		}
+		for tag, pc := range dirCfg.Policies {
+			if merged.Policies == nil {
+				merged.Policies = make(map[string]policyConfig)
+			}
+			prev := merged.Policies[tag]
+			if pc.Untagged == nil {
+				pc.Untagged = prev.Untagged
+			}
+			if pc.Unexported == nil {
+				pc.Unexported = prev.Unexported
+			}
+			if pc.Naming == "" {
+				pc.Naming = prev.Naming
+			}
+			pc.Idents = mergeStrings(prev.Idents, pc.Idents)
+			merged.Policies[tag] = pc
+		}
+	}
+	return merged
+}
+
+// policies returns the tag policies of the dir, applied over those of the project
+func (dirCfg dirConfig) policies() map[string]model.TagPolicy {
+	if len(dirCfg.Policies) == 0 {
+		return nil
+	}
+	policies := make(map[string]model.TagPolicy)
+	for tag, pc := range dirCfg.Policies {
+		policies[tag], _ = pc.apply(model.PolicyFor(tag))
+	}
+	return policies
+}
+
+// identPatterns returns the templates naming the identifiers generated for tag in the dir, by kind:
+// those of the project, overridden by those of the tag's policy, and then by those of the dir's
+func (cfg *config) identPatterns(tag string, dirCfg dirConfig) map[string]string {
+	return mergeStrings(mergeStrings(cfg.Idents, cfg.Policies[tag].Idents), dirCfg.Policies[tag].Idents)
+}
+
+// mergeStrings returns the entries of both maps, those of over winning
+func mergeStrings(base, over map[string]string) map[string]string {
+	merged := map[string]string{}
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range over {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,189 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bradleygore/go-stag/model"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestForDir(t *testing.T) {
+	cfg := &config{
+		dir: filepath.FromSlash("/proj"),
+		Dirs: map[string]dirConfig{
+			"models": {
+				Tags:     []string{"json"},
+				Types:    []string{"User*"},
+				FileName: "{name}.gen-{tag}.go",
+				Policies: map[string]policyConfig{"db": {Untagged: boolPtr(true), Naming: "snake", Idents: map[string]string{"var": "{{.Struct}}Cols", "isValid": "Valid{{.Struct}}Col"}}},
+			},
+			"models/legacy": {
+				Tags:     []string{"db"},
+				Policies: map[string]policyConfig{"db": {Naming: "lower", Idents: map[string]string{"var": "{{.Struct}}Legacy"}}},
+			},
+			"models/legacy/gen": {Skip: true},
+			"vendor":            {Skip: true, ExcludeTypes: []string{"*"}},
+		},
+	}
+	tests := []struct {
+		name string
+		dir  string
+		want dirConfig
+	}{
+		{name: "outside every configured dir", dir: "/proj/cmd", want: dirConfig{}},
+		{name: "a dir named like the start of one", dir: "/proj/modelsx", want: dirConfig{}},
+		{name: "a configured dir", dir: "/proj/models", want: cfg.Dirs["models"]},
+		{
+			name: "nested dirs override their parents setting by setting",
+			dir:  "/proj/models/legacy/sub",
+			want: dirConfig{
+				Tags:     []string{"db"},
+				Types:    []string{"User*"},
+				FileName: "{name}.gen-{tag}.go",
+				Policies: map[string]policyConfig{"db": {Untagged: boolPtr(true), Naming: "lower", Idents: map[string]string{"var": "{{.Struct}}Legacy", "isValid": "Valid{{.Struct}}Col"}}},
+			},
+		},
+		{
+			name: "skipping a dir skips those beneath it",
+			dir:  "/proj/models/legacy/gen/more",
+			want: dirConfig{
+				Skip:     true,
+				Tags:     []string{"db"},
+				Types:    []string{"User*"},
+				FileName: "{name}.gen-{tag}.go",
+				Policies: map[string]policyConfig{"db": {Untagged: boolPtr(true), Naming: "lower", Idents: map[string]string{"var": "{{.Struct}}Legacy", "isValid": "Valid{{.Struct}}Col"}}},
+			},
+		},
+		{name: "types and excludeTypes override together", dir: "/proj/vendor", want: dirConfig{Skip: true, ExcludeTypes: []string{"*"}}},
+	}
+	for _, tt := range tests {
+		if got := cfg.forDir(filepath.FromSlash(tt.dir)); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: forDir(%s) = %+v, want %+v", tt.name, tt.dir, got, tt.want)
+		}
+	}
+}
+
+func TestIdentPatterns(t *testing.T) {
+	cfg := &config{
+		Idents:   map[string]string{"var": "{{.Struct}}Names", "isValid": "IsValid{{.Struct}}"},
+		Policies: map[string]policyConfig{"db": {Idents: map[string]string{"var": "{{.Struct}}Columns"}}},
+	}
+	dirCfg := dirConfig{Policies: map[string]policyConfig{"db": {Idents: map[string]string{"isValid": "Has{{.Struct}}Column"}}}}
+	tests := []struct {
+		tag    string
+		dirCfg dirConfig
+		want   map[string]string
+	}{
+		{tag: "json", want: map[string]string{"var": "{{.Struct}}Names", "isValid": "IsValid{{.Struct}}"}},
+		{tag: "db", want: map[string]string{"var": "{{.Struct}}Columns", "isValid": "IsValid{{.Struct}}"}},
+		{tag: "db", dirCfg: dirCfg, want: map[string]string{"var": "{{.Struct}}Columns", "isValid": "Has{{.Struct}}Column"}},
+		{tag: "json", dirCfg: dirCfg, want: map[string]string{"var": "{{.Struct}}Names", "isValid": "IsValid{{.Struct}}"}},
+	}
+	for _, tt := range tests {
+		if got := cfg.identPatterns(tt.tag, tt.dirCfg); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("identPatterns(%s, %+v) = %v, want %v", tt.tag, tt.dirCfg, got, tt.want)
+		}
+	}
+}
+
+func TestApplyFlags(t *testing.T) {
+	prevTags, prevNested, prevSep, prevSources := *tagsArg, *nested, *pathSep, sources
+	prevPolicy, prevSkipMarkers := model.TagPolicies["db"], model.SkipMarkers
+	t.Cleanup(func() {
+		*tagsArg, *nested, *pathSep, sources = prevTags, prevNested, prevSep, prevSources
+		model.TagPolicies["db"], model.SkipMarkers = prevPolicy, prevSkipMarkers
+	})
+
+	*tagsArg, sources = "json", nil
+	cfg := &config{
+		dir:         filepath.FromSlash("/proj"),
+		Tags:        []string{"db", "yaml"},
+		Nested:      boolPtr(true),
+		Sep:         new(string),
+		Sources:     []string{"./models", "example.com/other/..."},
+		SkipMarkers: []string{"-"},
+		Policies:    map[string]policyConfig{"db": {Naming: "kebab"}},
+	}
+	*cfg.Sep = "/"
+	// tags were given on the cmd line, so they win over the config
+	if err := cfg.applyFlags(map[string]bool{"tags": true}); err != nil {
+		t.Fatal(err)
+	}
+	if *tagsArg != "json" {
+		t.Errorf("-tags = %q, want the flag's json", *tagsArg)
+	}
+	if !*nested || *pathSep != "/" {
+		t.Errorf("-nested, -sep = %t, %q, want the config's true, /", *nested, *pathSep)
+	}
+	if want := (stringList{filepath.Join(cfg.dir, "models"), "example.com/other/..."}); !reflect.DeepEqual(sources, want) {
+		t.Errorf("-source = %v, want %v, relative to the config", sources, want)
+	}
+	if !reflect.DeepEqual(model.SkipMarkers, []string{"-"}) {
+		t.Errorf("SkipMarkers = %v, want the config's", model.SkipMarkers)
+	}
+	// settings the config's policy doesn't give are kept
+	if got := model.TagPolicies["db"]; got.Naming != model.NamingKebab || got.Untagged != prevPolicy.Untagged {
+		t.Errorf("db policy = %+v, want kebab naming over %+v", got, prevPolicy)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name, file, content string
+		err                 string
+	}{
+		{name: "empty yaml", file: "stag.yaml", content: ""},
+		{name: "yaml", file: "stag.yaml", content: "tags: [json]\noutput:\n  fileName: \"{pkg}_{name}.stag-{tag}.go\"\n"},
+		{name: "json", file: ".stag.json", content: `{"tags": ["json"], "dirs": {"models": {"tags": ["db"]}}}`},
+		{name: "unknown setting", file: "stag.yaml", content: "tag: [json]\n", err: "field tag not found"},
+		{name: "unknown json setting", file: ".stag.json", content: `{"tag": ["json"]}`, err: `unknown field "tag"`},
+		{name: "file name without {tag}", file: "stag.yaml", content: "output:\n  fileName: \"{name}.stag.go\"\n", err: "must hold {tag}"},
+		{name: "file name without {name}", file: "stag.yaml", content: "output:\n  fileName: \"{pkg}.stag-{tag}.go\"\n", err: "must hold {name}"},
+		{name: "dir file name in a subdir", file: "stag.yaml", content: "dirs:\n  models:\n    fileName: \"gen/{name}.stag-{tag}.go\"\n", err: "dir models"},
+		{name: "unknown naming", file: "stag.yaml", content: "policies:\n  db: {naming: screaming}\n", err: `unknown naming "screaming"`},
+	}
+	for _, tt := range tests {
+		path := filepath.Join(t.TempDir(), tt.file)
+		if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := loadConfig(path)
+		switch {
+		case tt.err == "" && err != nil:
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		case tt.err != "" && (err == nil || !strings.Contains(err.Error(), tt.err)):
+			t.Errorf("%s: error = %v, want one holding %q", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestDstFileNameFor(t *testing.T) {
+	dir := filepath.FromSlash("/proj/models")
+	tests := []struct {
+		name      string
+		file      string
+		pattern   string
+		typeNames []string
+		want      string
+	}{
+		{name: "default", file: "user.go", want: "user.stag-json.go"},
+		{name: "pattern", file: "user.go", pattern: "{pkg}_{name}.stag-{tag}.go", want: "models_user.stag-json.go"},
+		{name: "test file", file: "user_test.go", want: "user.stag-json_test.go"},
+		{name: "types below a go:generate line", file: "user.go", typeNames: []string{"User", "Admin"}, want: "user_user_admin.stag-json.go"},
+		{name: "test file with types", file: "user_test.go", typeNames: []string{"Fixture"}, want: "user_fixture.stag-json_test.go"},
+	}
+	for _, tt := range tests {
+		f := &model.File{BasePath: filepath.Join(dir, tt.file), PkgName: "models"}
+		want := filepath.Join(dir, tt.want)
+		if got := dstFileNameFor(f, "json", tt.pattern, tt.typeNames); got != want {
+			t.Errorf("%s: dstFileNameFor(%s) = %s, want %s", tt.name, tt.file, got, want)
+		}
+	}
+}
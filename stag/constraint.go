@@ -0,0 +1,71 @@
+package main
+
+import (
+	"go/ast"
+	"go/build/constraint"
+	"path/filepath"
+	"strings"
+)
+
+// known GOOS and GOARCH values, as go/build uses to match file name suffixes
+var (
+	knownOS = map[string]bool{
+		"aix": true, "android": true, "darwin": true, "dragonfly": true, "freebsd": true, "hurd": true,
+		"illumos": true, "ios": true, "js": true, "linux": true, "nacl": true, "netbsd": true, "openbsd": true,
+		"plan9": true, "solaris": true, "wasip1": true, "windows": true, "zos": true,
+	}
+	knownArch = map[string]bool{
+		"386": true, "amd64": true, "amd64p32": true, "arm": true, "armbe": true, "arm64": true, "arm64be": true,
+		"loong64": true, "mips": true, "mipsle": true, "mips64": true, "mips64le": true, "mips64p32": true,
+		"mips64p32le": true, "ppc": true, "ppc64": true, "ppc64le": true, "riscv": true, "riscv64": true,
+		"s390": true, "s390x": true, "sparc": true, "sparc64": true, "wasm": true,
+	}
+)
+
+// buildConstraintOf returns the build constraint a file compiles under, combining its //go:build line
+// with any GOOS/GOARCH implied by its name. Generated files need it spelled out, as their names
+// (i.e. user_linux.stag-json.go) no longer end in the suffix.
+func buildConstraintOf(filePath string, f *ast.File) string {
+	var expr constraint.Expr
+	for _, group := range f.Comments {
+		if group.Pos() >= f.Package {
+			break
+		}
+		for _, c := range group.List {
+			if constraint.IsGoBuild(c.Text) {
+				if parsed, err := constraint.Parse(c.Text); err == nil {
+					expr = parsed
+				}
+			}
+		}
+	}
+	if nameExpr := fileNameConstraint(filePath); nameExpr != nil {
+		if expr == nil {
+			expr = nameExpr
+		} else {
+			expr = &constraint.AndExpr{X: expr, Y: nameExpr}
+		}
+	}
+	if expr == nil {
+		return ""
+	}
+	return expr.String()
+}
+
+// fileNameConstraint returns the constraint implied by a name like foo_linux.go, foo_arm64.go or foo_linux_arm64_test.go
+func fileNameConstraint(filePath string) constraint.Expr {
+	name := strings.TrimSuffix(filepath.Base(filePath), ".go")
+	name = strings.TrimSuffix(name, "_test")
+	parts := strings.Split(name, "_")
+	if len(parts) < 2 {
+		return nil
+	}
+	last := parts[len(parts)-1]
+	if len(parts) >= 3 && knownOS[parts[len(parts)-2]] && knownArch[last] {
+		return &constraint.AndExpr{X: &constraint.TagExpr{Tag: parts[len(parts)-2]}, Y: &constraint.TagExpr{Tag: last}}
+	}
+	if knownOS[last] || knownArch[last] {
+		return &constraint.TagExpr{Tag: last}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestFileNameConstraint(t *testing.T) {
+	tests := []struct {
+		filePath string
+		want     string
+	}{
+		{filePath: "user.go", want: ""},
+		{filePath: "user_test.go", want: ""},
+		{filePath: "user_linux.go", want: "linux"},
+		{filePath: "user_arm64.go", want: "arm64"},
+		{filePath: "user_linux_amd64.go", want: "linux && amd64"},
+		{filePath: "user_linux_amd64_test.go", want: "linux && amd64"},
+		{filePath: "user_windows_test.go", want: "windows"},
+		{filePath: "path/to/user_darwin.go", want: "darwin"},
+		// only the last two parts count, and an arch can't come before the OS
+		{filePath: "user_amd64_linux.go", want: "linux"},
+		{filePath: "user_linux_amd64_extra.go", want: ""},
+		// go/build leaves out the part before the first underscore, so a lone GOOS is just a name
+		{filePath: "linux.go", want: ""},
+		{filePath: "linux_amd64.go", want: "amd64"},
+		// unix is a build tag but not a file name suffix
+		{filePath: "user_unix.go", want: ""},
+		{filePath: "user_tests.go", want: ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if expr := fileNameConstraint(tt.filePath); expr != nil {
+			got = expr.String()
+		}
+		if got != tt.want {
+			t.Errorf("fileNameConstraint(%q) = %q, want %q", tt.filePath, got, tt.want)
+		}
+	}
+}
+
+func TestBuildConstraintOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		filePath string
+		src      string
+		want     string
+	}{
+		{name: "none", filePath: "user.go", src: "package p\n", want: ""},
+		{name: "go:build line", filePath: "user.go", src: "//go:build cgo\n\npackage p\n", want: "cgo"},
+		{name: "name suffix", filePath: "user_linux_test.go", src: "package p\n", want: "linux"},
+		{name: "go:build line and name suffix", filePath: "user_linux.go", src: "//go:build cgo\n\npackage p\n", want: "cgo && linux"},
+		{name: "alternatives keep their parens", filePath: "user_amd64.go", src: "//go:build a || b\n\npackage p\n", want: "(a || b) && amd64"},
+		{name: "below a license header", filePath: "user.go", src: "// Copyright\n\n//go:build !windows\n\npackage p\n", want: "!windows"},
+		{name: "after the package clause", filePath: "user.go", src: "package p\n\n//go:build ignore\n", want: ""},
+	}
+	for _, tt := range tests {
+		f, err := parser.ParseFile(token.NewFileSet(), tt.filePath, tt.src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if got := buildConstraintOf(tt.filePath, f); got != tt.want {
+			t.Errorf("%s: buildConstraintOf(%q) = %q, want %q", tt.name, tt.filePath, got, tt.want)
+		}
+	}
+}
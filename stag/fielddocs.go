@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+
+	"github.com/bradleygore/go-stag/model"
+)
+
+// fieldDocs finds the doc and directives of a field by where it's declared: among the parsed files, or else by
+// parsing the file declaring it, as the type checker only has the positions of the fields of imported pkgs
+type fieldDocs struct {
+	byPos  map[fieldPos]model.FieldTagName
+	parsed map[string]bool // files whose fields are in byPos
+}
+
+// fieldPos is where a field's name is declared. Imported pkgs only carry the line, so the fields of files
+// parsed here are found by it alone, having no column.
+type fieldPos struct {
+	file         string
+	line, column int
+}
+
+func posOf(pos token.Position) fieldPos {
+	return fieldPos{file: pos.Filename, line: pos.Line, column: pos.Column}
+}
+
+func newFieldDocs(parsed model.Files) *fieldDocs {
+	fd := &fieldDocs{byPos: make(map[fieldPos]model.FieldTagName), parsed: make(map[string]bool)}
+	for _, f := range parsed {
+		fd.parsed[f.BasePath] = true
+		for _, s := range f.Structs {
+			for _, ftns := range append([]model.FieldTagNames{s.Fields, s.Embeds}, mapValues(s.FieldTagNames)...) {
+				for _, ftn := range ftns {
+					fd.byPos[posOf(ftn.Position)] = ftn
+				}
+			}
+		}
+	}
+	return fd
+}
+
+func mapValues(fieldTagNames map[string]model.FieldTagNames) []model.FieldTagNames {
+	values := []model.FieldTagNames{}
+	for _, ftns := range fieldTagNames {
+		values = append(values, ftns)
+	}
+	return values
+}
+
+// at returns the field declared at pos, which only has its doc and directives when its file wasn't among the parsed ones
+func (fd *fieldDocs) at(pos token.Position) (model.FieldTagName, error) {
+	if pos.Filename != "" && !fd.parsed[pos.Filename] {
+		fd.parsed[pos.Filename] = true
+		if err := fd.parseFile(pos.Filename); err != nil {
+			return model.FieldTagName{}, err
+		}
+	}
+	if ftn, exists := fd.byPos[posOf(pos)]; exists {
+		return ftn, nil
+	}
+	return fd.byPos[fieldPos{file: pos.Filename, line: pos.Line}], nil
+}
+
+// parseFile adds the fields declared in filename; a file which can't be parsed, i.e. isn't around, just has no docs
+func (fd *fieldDocs) parseFile(filename string) error {
+	fset := token.NewFileSet()
+	astf, err := parser.ParseFile(fset, filename, nil, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil
+	}
+	errs := []error{}
+	v := visitor{fset: fset, errs: &errs}
+	ast.Inspect(astf, func(node ast.Node) bool {
+		field, ok := node.(*ast.Field)
+		if !ok {
+			return true
+		}
+		names := field.Names
+		// an embed is declared where the type checker places it, at the identifier naming its type
+		if ident := embedIdent(field.Type); len(names) == 0 && ident != nil {
+			names = []*ast.Ident{ident}
+		}
+		doc, directives := docOf(field.Doc, field.Comment), v.directivesOf(field.Doc, field.Comment)
+		for _, name := range names {
+			pos := fieldPos{file: filename, line: fset.Position(name.Pos()).Line}
+			if _, exists := fd.byPos[pos]; !exists {
+				fd.byPos[pos] = model.FieldTagName{FieldName: name.Name, Doc: doc, Directives: directives}
+			}
+		}
+		return true
+	})
+	return errors.Join(errs...)
+}
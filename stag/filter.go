@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// typeFilter selects the structs to generate for by name. Patterns are globs (User*), or regexps
+// when wrapped in slashes (/^User(Page)?$/). Every struct is still seen when resolving embeds.
+type typeFilter struct {
+	include []typePattern // when empty, every struct not excluded is included
+	exclude []typePattern
+	names   map[string]bool // when set, only these structs may be included, i.e. those below a //go:generate line
+}
+
+type typePattern struct {
+	glob string
+	rx   *regexp.Regexp
+}
+
+func (tp typePattern) matches(name string) bool {
+	if tp.rx != nil {
+		return tp.rx.MatchString(name)
+	}
+	ok, _ := path.Match(tp.glob, name)
+	return ok
+}
+
+func newTypeFilter(include, exclude string) (typeFilter, error) {
+	tf := typeFilter{}
+	var err error
+	if tf.include, err = parseTypePatterns(include); err != nil {
+		return tf, err
+	}
+	if tf.exclude, err = parseTypePatterns(exclude); err != nil {
+		return tf, err
+	}
+	return tf, nil
+}
+
+func parseTypePatterns(arg string) ([]typePattern, error) {
+	patterns := []typePattern{}
+	for _, p := range strings.Split(arg, ",") {
+		p = strings.TrimSpace(p)
+		switch {
+		case p == "":
+			continue
+		case len(p) > 2 && strings.HasPrefix(p, "/") && strings.HasSuffix(p, "/"):
+			rx, err := regexp.Compile(p[1 : len(p)-1])
+			if err != nil {
+				return nil, fmt.Errorf("bad type regexp %s: %w", p, err)
+			}
+			patterns = append(patterns, typePattern{rx: rx})
+		default:
+			if _, err := path.Match(p, ""); err != nil {
+				return nil, fmt.Errorf("bad type glob %s: %w", p, err)
+			}
+			patterns = append(patterns, typePattern{glob: p})
+		}
+	}
+	return patterns, nil
+}
+
+func (tf typeFilter) matches(name string) bool {
+	if tf.names != nil && !tf.names[name] {
+		return false
+	}
+	for _, tp := range tf.exclude {
+		if tp.matches(name) {
+			return false
+		}
+	}
+	if len(tf.include) == 0 {
+		return true
+	}
+	for _, tp := range tf.include {
+		if tp.matches(name) {
+			return true
+		}
+	}
+	return false
+}
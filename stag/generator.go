@@ -3,10 +3,15 @@ package main
 import (
 	"bytes"
 	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
 	"log"
+	"path/filepath"
 	"strings"
 
 	"github.com/bradleygore/go-stag/model"
+	"golang.org/x/tools/go/ast/astutil"
 	toolsimports "golang.org/x/tools/imports"
 )
 
@@ -16,97 +21,108 @@ type generator struct {
 	file        *model.File
 	tag         string
 	dstFileName string // compiled during Generate
+	nestSep     string // joins nested field paths; nesting is disabled when empty
+	filter      typeFilter
+	cmdTags     []string // tags given on the cmd line, generated for structs without a //stag:generate directive
+	idents      identTemplates
+	tmpl        *stagTemplate
+	imports     map[string]string // added by the template, by path, to their names
 }
 
-func (g *generator) indent() {
-	g.indentLevel += 1
-}
-func (g *generator) outdent() {
-	if g.indentLevel > 0 {
-		g.indentLevel -= 1
+// structs returns the structs of the file to generate for
+func (g *generator) structs() model.Structures {
+	strucs := model.Structures{}
+	for _, s := range g.file.Structs.HavingTags([]string{g.tag}) {
+		if g.filter.matches(s.Name) && s.Directives.Generates(g.tag, g.cmdTags) {
+			strucs = append(strucs, s)
+		}
 	}
+	return strucs
 }
 
-func (g *generator) spacer() string {
-	return strings.Repeat("\t", g.indentLevel)
-}
-
-func (g *generator) fp(format string, args ...interface{}) {
-	fmt.Fprintf(&g.buf, g.spacer()+format+"\n", args...)
+// identsFor returns the names of the identifiers generated for s
+func (g *generator) identsFor(s *model.Structure) structIdents {
+	ids, err := g.idents.forStruct(s, g.tag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return ids
 }
 
+// Generate executes the generator's template, leaving the output empty when there's nothing to generate for
 func (g *generator) Generate() {
 	if g.file == nil {
 		log.Fatal("cannot Generate with no file")
 	}
-	strucs := g.file.Structs.HavingTags([]string{g.tag})
+	strucs := g.structs()
 	if len(strucs) == 0 {
 		return
 	}
-	tagUpper := strings.ToUpper(g.tag)
-	g.fp("// Code generated by stag. DO NOT EDIT.")
-	g.fp("// Source file: %s", g.file.FileName())
-	g.fp("")
-	g.fp("package %s", g.file.PkgName)
-	g.fp("")
-	for _, s := range strucs {
-		structName := fmt.Sprintf("%s_%s", s.Name, tagUpper)
-		allTagFieldNamesProp := fmt.Sprintf("All%sFieldNames", tagUpper)
-		g.fp("var %s = struct {", structName)
-		g.indent()
-		fields := s.FieldTagNames[g.tag]
-		for _, field := range fields {
-			if field.IsSkipped() {
-				continue
-			}
-			g.fp("%s string", field.FieldName)
-		}
-		g.fp("%s []string", allTagFieldNamesProp)
-		g.outdent()
-		g.fp("}{")
-		g.fp("")
-		g.indent()
-		for _, field := range fields {
-			if field.IsSkipped() {
-				continue
-			}
-			g.fp(`%s:"%s",`, field.FieldName, field.TagName)
-		}
-		allTagNames := ""
-		for idx, tn := range fields.TagNames() {
-			if idx > 0 {
-				allTagNames += ","
-			}
-			allTagNames += fmt.Sprintf(`"%s"`, tn)
-		}
-		g.fp("%s:[]string{%s},", allTagFieldNamesProp, allTagNames)
-		g.outdent()
-		g.fp("}\n")
-		g.fp("")
-		g.fp("func IsValid%sField(f string) bool {", structName)
-		g.indent()
-		g.fp("for _, fn := range %s.%s {", structName, allTagFieldNamesProp)
-		g.indent()
-		g.fp("if fn == f {")
-		g.indent()
-		g.fp("return true")
-		g.outdent()
-		g.fp("}")
-		g.outdent()
-		g.fp("}")
-		g.fp("return false")
-		g.outdent()
-		g.fp("}")
-		g.fp("")
-	}
-	g.fp("")
+	g.imports = map[string]string{}
+	tmpl, err := g.tmpl.tmpl.Clone()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := tmpl.Funcs(templateFuncs(g.imports)).Execute(&g.buf, g.templateData(strucs)); err != nil {
+		log.Fatalf("Failed executing template for %s: %v", g.file.BasePath, err)
+	}
+}
+
+// sourcePosition returns pos relative to the generated file's dir, or just its file name
+// when it lies outside of it, so generated output doesn't depend on where it was generated
+func (g *generator) sourcePosition(pos token.Position) string {
+	fileName := filepath.Base(pos.Filename)
+	if rel, err := filepath.Rel(filepath.Dir(g.file.BasePath), pos.Filename); err == nil && !strings.HasPrefix(rel, "..") {
+		fileName = filepath.ToSlash(rel)
+	}
+	return fmt.Sprintf("%s:%d", fileName, pos.Line)
 }
 
-// Output returns the generator's output, formatted in the standard Go style.
+// nestedFields returns the fields of a field's struct type to generate as a nested object, if any: those
+// joined from its embeds, or, when neither it nor its embeds carry the tag, those its policy gives it
+func (g *generator) nestedFields(field model.FieldTagName, visiting map[*model.Structure]bool) model.FieldTagNames {
+	if g.nestSep == "" || field.Nested == nil || visiting[field.Nested] {
+		return nil
+	}
+	fields, joined := field.Nested.FieldTagNames[g.tag]
+	if !joined {
+		fields = field.Nested.FieldsFor(g.tag)
+	}
+	if fields.AllSkipped() {
+		return nil
+	}
+	return fields
+}
+
+// Output returns the generator's output; Go code is formatted in the standard Go style and has its imports fixed.
 func (g *generator) Output() []byte {
-	src, err := toolsimports.Process(g.dstFileName, g.buf.Bytes(), nil)
+	if !g.tmpl.isGo() {
+		return g.buf.Bytes()
+	}
+	src := g.buf.Bytes()
+	if len(g.imports) > 0 {
+		src = g.addImports(src)
+	}
+	src, err := toolsimports.Process(g.dstFileName, src, nil)
 	if err != nil {
 		log.Fatalf("Failed to format generated source code: %s\n%s", err, g.buf.String())
 	}
 	return src
 }
+
+// addImports adds the imports asked for by the template to src
+func (g *generator) addImports(src []byte) []byte {
+	fset := token.NewFileSet()
+	astf, err := parser.ParseFile(fset, g.dstFileName, src, parser.ParseComments)
+	if err != nil {
+		log.Fatalf("Failed to parse generated source code: %s\n%s", err, src)
+	}
+	for path, name := range g.imports {
+		astutil.AddNamedImport(fset, astf, name, path)
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, astf); err != nil {
+		log.Fatalf("Failed to print generated source code: %s", err)
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"os"
+	"strconv"
+)
+
+// goGenerate is where go generate invoked stag from, as told by its environment
+type goGenerate struct {
+	file    string // $GOFILE, the base name of the file holding the //go:generate line
+	pkgName string // $GOPACKAGE, the name of that file's pkg
+	line    int    // $GOLINE, the line of the //go:generate comment
+}
+
+// goGenerateEnv returns the go generate invocation stag is running under, if any
+func goGenerateEnv() (goGenerate, bool) {
+	gg := goGenerate{file: os.Getenv("GOFILE"), pkgName: os.Getenv("GOPACKAGE")}
+	if gg.file == "" {
+		return gg, false
+	}
+	gg.line, _ = strconv.Atoi(os.Getenv("GOLINE"))
+	return gg, true
+}
+
+// typesBelow returns the names of the types declared directly below the //go:generate line, either
+// on the next line or with the line as part of their doc comment. A //go:generate line which isn't
+// directly above a type, i.e. at the top of the file, returns nil.
+func (gg goGenerate) typesBelow(fset *token.FileSet, astf *ast.File) []string {
+	if gg.line <= 0 {
+		return nil
+	}
+	below := func(doc *ast.CommentGroup, pos token.Pos) bool {
+		if doc != nil {
+			for _, c := range doc.List {
+				if fset.Position(c.Pos()).Line == gg.line {
+					return true
+				}
+			}
+		}
+		return fset.Position(pos).Line == gg.line+1
+	}
+	for _, dec := range astf.Decls {
+		decl, ok := dec.(*ast.GenDecl)
+		if !ok || decl.Tok != token.TYPE {
+			continue
+		}
+		names := []string{}
+		declBelow := below(decl.Doc, decl.Pos())
+		for _, spec := range decl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			// within a grouped type ( ... ) declaration, the line may be above a single spec
+			if declBelow || below(typeSpec.Doc, typeSpec.Pos()) {
+				names = append(names, typeSpec.Name.Name)
+			}
+		}
+		if len(names) > 0 {
+			return names
+		}
+	}
+	return nil
+}
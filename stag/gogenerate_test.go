@@ -0,0 +1,92 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTypesBelow(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want []string
+	}{
+		{
+			name: "top of the file",
+			src:  "package p\n\n//go:generate stag -tags=json\n\ntype User struct{}\n",
+			want: nil,
+		},
+		{
+			name: "on the line above a type",
+			src:  "package p\n\n//go:generate stag -tags=json\ntype User struct{}\n\ntype Admin struct{}\n",
+			want: []string{"User"},
+		},
+		{
+			name: "below the type's doc",
+			src:  "package p\n\n// User is an account holder\n//go:generate stag -tags=json\ntype User struct{}\n",
+			want: []string{"User"},
+		},
+		{
+			name: "above the type's doc",
+			src:  "package p\n\n//go:generate stag -tags=json\n// User is an account holder\ntype User struct{}\n",
+			want: []string{"User"},
+		},
+		{
+			name: "separated by a blank line",
+			src:  "package p\n\n//go:generate stag -tags=json\n\n// User is an account holder\ntype User struct{}\n",
+			want: nil,
+		},
+		{
+			name: "above a grouped declaration",
+			src:  "package p\n\n//go:generate stag -tags=json\ntype (\n\tUser struct{}\n\tAdmin struct{}\n)\n",
+			want: []string{"User", "Admin"},
+		},
+		{
+			name: "above a spec within a grouped declaration",
+			src:  "package p\n\ntype (\n\tUser struct{}\n\t//go:generate stag -tags=json\n\tAdmin struct{}\n)\n",
+			want: []string{"Admin"},
+		},
+		{
+			name: "above a func",
+			src:  "package p\n\n//go:generate stag -tags=json\nfunc f() {}\n\ntype User struct{}\n",
+			want: nil,
+		},
+		{
+			name: "second of several lines",
+			src:  "package p\n\n//go:generate stag -tags=json\ntype User struct{}\n\n//go:generate stag -tags=json\ntype Admin struct{}\n",
+			want: []string{"Admin"},
+		},
+	}
+	for _, tt := range tests {
+		fset := token.NewFileSet()
+		astf, err := parser.ParseFile(fset, "user.go", tt.src, parser.ParseComments)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		// the last //go:generate line is the one being run
+		lines := strings.Split(tt.src, "\n")
+		gg := goGenerate{file: "user.go", pkgName: "p"}
+		for idx, line := range lines {
+			if strings.HasPrefix(strings.TrimSpace(line), "//go:generate") {
+				gg.line = idx + 1
+			}
+		}
+		if got := gg.typesBelow(fset, astf); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: typesBelow() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestTypesBelowWithoutLine(t *testing.T) {
+	fset := token.NewFileSet()
+	astf, err := parser.ParseFile(fset, "user.go", "package p\n\ntype User struct{}\n", parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (goGenerate{file: "user.go"}).typesBelow(fset, astf); got != nil {
+		t.Errorf("typesBelow() without $GOLINE = %v, want nil", got)
+	}
+}
@@ -0,0 +1,266 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/bradleygore/go-stag/model"
+	"golang.org/x/tools/go/packages"
+)
+
+// identKinds are the generated identifiers which can be named by a template, along with their default templates
+var identKinds = map[string]string{
+	"var":             "{{.Struct}}_{{upper .Tag}}",
+	"allFieldNames":   "All{{upper .Tag}}FieldNames",
+	"isValid":         "IsValid{{.Struct}}_{{upper .Tag}}Field",
+	"fields":          "{{.Struct}}_{{upper .Tag}}_Fields",
+	"options":         "{{.Struct}}_{{upper .Tag}}_Options",
+	"hasOption":       "Has{{.Struct}}_{{upper .Tag}}FieldOption",
+	"allGoFieldNames": "AllGoFieldNames",
+	"goNameFor":       "{{.Struct}}_{{upper .Tag}}GoNameFor",
+	"tagNameFor":      "{{.Struct}}_{{upper .Tag}}TagNameFor",
+	// those of the const style
+	"type":   "{{.Struct}}{{pascal .Tag}}Field",
+	"const":  "{{.Struct}}{{pascal .Tag}}Field{{.Field}}",
+	"values": "{{.Struct}}{{pascal .Tag}}FieldValues",
+	"parse":  "Parse{{.Struct}}{{pascal .Tag}}Field",
+}
+
+// identFuncs are the case helpers of ident templates, i.e. {{pascal .Tag}}
+var identFuncs = template.FuncMap{
+	"upper":  strings.ToUpper,
+	"lower":  strings.ToLower,
+	"pascal": model.NamingPascal.Apply,
+	"camel":  model.NamingCamel.Apply,
+	"snake":  model.NamingSnake.Apply,
+	"kebab":  model.NamingKebab.Apply,
+}
+
+// identData is what ident templates are executed with
+type identData struct {
+	Struct string // name of the struct, or that given by a //stag:ident directive
+	Tag    string
+	Field  string // name of the field in generated code, for the const of a field
+}
+
+// identTemplates name the identifiers generated for a tag, by kind
+type identTemplates map[string]*template.Template
+
+// parseIdentTemplates parses the templates by kind over those of base, or the defaults when base is nil
+func parseIdentTemplates(base identTemplates, patterns map[string]string) (identTemplates, error) {
+	its := identTemplates{}
+	for kind, pattern := range identKinds {
+		if base != nil {
+			its[kind] = base[kind]
+			continue
+		}
+		its[kind] = template.Must(template.New(kind).Funcs(identFuncs).Parse(pattern))
+	}
+	for kind, pattern := range patterns {
+		if _, exists := identKinds[kind]; !exists {
+			return nil, fmt.Errorf("unknown identifier kind %q; one of %s", kind, strings.Join(identKindNames(), " | "))
+		}
+		tmpl, err := template.New(kind).Funcs(identFuncs).Parse(pattern)
+		if err == nil {
+			// referring to data which doesn't exist only fails once executed
+			err = tmpl.Execute(io.Discard, identData{Struct: "User", Tag: "json", Field: "Name"})
+		}
+		if err != nil {
+			return nil, fmt.Errorf("identifier template for %s: %w", kind, err)
+		}
+		its[kind] = tmpl
+	}
+	return its, nil
+}
+
+// parseIdentPatterns reads kind=template pairs separated by semicolons, i.e. var={{.Struct}}{{pascal .Tag}}Fields
+func parseIdentPatterns(arg string) (map[string]string, error) {
+	patterns := map[string]string{}
+	for _, pair := range strings.Split(arg, ";") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+		eq := strings.IndexRune(pair, '=')
+		if eq <= 0 || eq == len(pair)-1 {
+			return nil, fmt.Errorf("identifier templates must be given as kind=template pairs, got %q", pair)
+		}
+		patterns[pair[:eq]] = pair[eq+1:]
+	}
+	return patterns, nil
+}
+
+func identKindNames() []string {
+	names := []string{}
+	for kind := range identKinds {
+		names = append(names, kind)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// name executes the template of kind, returning a valid Go identifier
+func (its identTemplates) name(kind string, data identData) (string, error) {
+	var buf bytes.Buffer
+	if err := its[kind].Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("identifier template for %s: %w", kind, err)
+	}
+	return sanitizeIdent(buf.String()), nil
+}
+
+// sanitizeIdent makes name a valid Go identifier: characters which can't be in one become underscores,
+// i.e. the DB-COL of a db-col tag to DB_COL, a leading digit is prefixed by one, and keywords get a trailing one
+func sanitizeIdent(name string) string {
+	runes := []rune(strings.TrimSpace(name))
+	for idx, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+			runes[idx] = '_'
+		}
+	}
+	ident := string(runes)
+	if ident == "" || unicode.IsDigit(runes[0]) {
+		ident = "_" + ident
+	}
+	if token.IsKeyword(ident) {
+		ident += "_"
+	}
+	return ident
+}
+
+// structIdents are the names of the identifiers generated for a struct and tag
+type structIdents struct {
+	Var, AllFieldNames, IsValid, Fields, Options, HasOption string
+	AllGoFieldNames, GoNameFor, TagNameFor                  string
+	Type, Values, Parse                                     string
+}
+
+func (its identTemplates) forStruct(s *model.Structure, tag string) (structIdents, error) {
+	data := identData{Struct: s.Ident(), Tag: tag}
+	ids := structIdents{}
+	for kind, dst := range map[string]*string{
+		"var":             &ids.Var,
+		"allFieldNames":   &ids.AllFieldNames,
+		"isValid":         &ids.IsValid,
+		"fields":          &ids.Fields,
+		"options":         &ids.Options,
+		"hasOption":       &ids.HasOption,
+		"allGoFieldNames": &ids.AllGoFieldNames,
+		"goNameFor":       &ids.GoNameFor,
+		"tagNameFor":      &ids.TagNameFor,
+		"type":            &ids.Type,
+		"values":          &ids.Values,
+		"parse":           &ids.Parse,
+	} {
+		var err error
+		if *dst, err = its.name(kind, data); err != nil {
+			return ids, err
+		}
+	}
+	return ids, nil
+}
+
+// forField returns the name of the const generated for a field of s
+func (its identTemplates) forField(s *model.Structure, tag string, field model.FieldTagName) (string, error) {
+	return its.name("const", identData{Struct: s.Ident(), Tag: tag, Field: field.Ident()})
+}
+
+// declaredIdents adds the identifiers declared at the pkg level of pkg to idents, leaving out those
+// of stag-generated files, which are about to be regenerated
+func declaredIdents(pkg *packages.Package, idents map[string]bool) map[string]bool {
+	if idents == nil {
+		idents = make(map[string]bool)
+	}
+	if pkg.Types == nil {
+		return idents
+	}
+	scope := pkg.Types.Scope()
+	for _, name := range scope.Names() {
+		if pos := pkg.Fset.Position(scope.Lookup(name).Pos()); !rxIsStagFile.MatchString(pos.Filename) {
+			idents[name] = true
+		}
+	}
+	return idents
+}
+
+// identCollisions returns an error for each identifier in generated Go code which is already declared in its
+// pkg or generated more than once, and for each field name repeated in a generated struct type
+func identCollisions(tags []string, tagGenerators map[string][]*generator, pkgIdents map[string]map[string]bool) []error {
+	errs := []error{}
+	generated := map[string]map[string]string{} // what each identifier was generated for, by pkg path
+	for _, tag := range tags {
+		for _, g := range tagGenerators[tag] {
+			if !g.tmpl.isGo() || g.buf.Len() == 0 {
+				continue
+			}
+			of := fmt.Sprintf("%s (%s, template %s)", g.file.FileName(), tag, g.tmpl.kind)
+			fset := token.NewFileSet()
+			astf, err := parser.ParseFile(fset, "", g.buf.Bytes(), 0)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("generated for %s: %w", of, err))
+				continue
+			}
+			if generated[g.file.PkgPath] == nil {
+				generated[g.file.PkgPath] = make(map[string]string)
+			}
+			for _, name := range topLevelNames(astf) {
+				switch {
+				case pkgIdents[g.file.PkgPath][name]:
+					errs = append(errs, fmt.Errorf("%s generated for %s is already declared in pkg %s", name, of, g.file.PkgName))
+				case generated[g.file.PkgPath][name] != "":
+					errs = append(errs, fmt.Errorf("%s generated for %s is also generated for %s", name, of, generated[g.file.PkgPath][name]))
+				default:
+					generated[g.file.PkgPath][name] = of
+				}
+			}
+			ast.Inspect(astf, func(n ast.Node) bool {
+				if st, ok := n.(*ast.StructType); ok {
+					seen := map[string]bool{}
+					for _, field := range st.Fields.List {
+						for _, name := range field.Names {
+							if seen[name.Name] {
+								errs = append(errs, fmt.Errorf("field %s is generated more than once in a struct for %s", name.Name, of))
+							}
+							seen[name.Name] = true
+						}
+					}
+				}
+				return true
+			})
+		}
+	}
+	return errs
+}
+
+// topLevelNames returns the names declared at the pkg level of a file, leaving out methods
+func topLevelNames(astf *ast.File) []string {
+	names := []string{}
+	for _, decl := range astf.Decls {
+		switch decl := decl.(type) {
+		case *ast.FuncDecl:
+			if decl.Recv == nil {
+				names = append(names, decl.Name.Name)
+			}
+		case *ast.GenDecl:
+			for _, spec := range decl.Specs {
+				switch spec := spec.(type) {
+				case *ast.TypeSpec:
+					names = append(names, spec.Name.Name)
+				case *ast.ValueSpec:
+					for _, name := range spec.Names {
+						if name.Name != "_" {
+							names = append(names, name.Name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return names
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/bradleygore/go-stag/model"
+)
+
+func TestSanitizeIdent(t *testing.T) {
+	tests := []struct {
+		name, want string
+	}{
+		{name: "User_JSON", want: "User_JSON"},
+		{name: "User_DB-COL", want: "User_DB_COL"},
+		{name: "User.Name", want: "User_Name"},
+		{name: "first name", want: "first_name"},
+		{name: " User ", want: "User"},
+		{name: "2fa", want: "_2fa"},
+		{name: "", want: "_"},
+		{name: "type", want: "type_"},
+		{name: "func", want: "func_"},
+		{name: "Type", want: "Type"},
+		{name: "ñandú", want: "ñandú"},
+	}
+	for _, tt := range tests {
+		if got := sanitizeIdent(tt.name); got != tt.want {
+			t.Errorf("sanitizeIdent(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestIdentTemplatesName(t *testing.T) {
+	its, err := parseIdentTemplates(nil, map[string]string{"var": "{{.Struct}}{{pascal .Tag}}Fields", "const": "{{.Struct}}{{.Field}}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		kind string
+		data identData
+		want string
+	}{
+		{kind: "var", data: identData{Struct: "User", Tag: "json"}, want: "UserJSONFields"},
+		{kind: "var", data: identData{Struct: "User", Tag: "db-col"}, want: "UserDBColFields"},
+		// the defaults are kept for kinds not given
+		{kind: "isValid", data: identData{Struct: "User", Tag: "db-col"}, want: "IsValidUser_DB_COLField"},
+		{kind: "const", data: identData{Struct: "User", Tag: "json", Field: "Base_Name"}, want: "UserBase_Name"},
+	}
+	for _, tt := range tests {
+		got, err := its.name(tt.kind, tt.data)
+		if err != nil {
+			t.Fatalf("name(%s, %+v): %v", tt.kind, tt.data, err)
+		}
+		if got != tt.want {
+			t.Errorf("name(%s, %+v) = %q, want %q", tt.kind, tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestParseIdentTemplates(t *testing.T) {
+	base, err := parseIdentTemplates(nil, map[string]string{"var": "{{.Struct}}Names"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// templates given over a base keep those of the base for the other kinds
+	over, err := parseIdentTemplates(base, map[string]string{"isValid": "Valid{{.Struct}}"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for kind, want := range map[string]string{"var": "UserNames", "isValid": "ValidUser"} {
+		if got, _ := over.name(kind, identData{Struct: "User", Tag: "json"}); got != want {
+			t.Errorf("name(%s) = %q, want %q", kind, got, want)
+		}
+	}
+
+	errTests := []struct {
+		patterns map[string]string
+		err      string
+	}{
+		{patterns: map[string]string{"vars": "{{.Struct}}"}, err: `unknown identifier kind "vars"`},
+		{patterns: map[string]string{"var": "{{.Struct"}, err: "identifier template for var"},
+		{patterns: map[string]string{"var": "{{.Strukt}}"}, err: "identifier template for var"},
+		{patterns: map[string]string{"var": "{{shout .Tag}}"}, err: "identifier template for var"},
+	}
+	for _, tt := range errTests {
+		if _, err := parseIdentTemplates(nil, tt.patterns); err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("parseIdentTemplates(%v) error = %v, want one holding %q", tt.patterns, err, tt.err)
+		}
+	}
+}
+
+func TestParseIdentPatterns(t *testing.T) {
+	got, err := parseIdentPatterns(" var={{.Struct}}Fields ; isValid=Is{{.Struct}}Field;")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"var": "{{.Struct}}Fields", "isValid": "Is{{.Struct}}Field"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("parseIdentPatterns() = %v, want %v", got, want)
+	}
+	for _, arg := range []string{"var", "=X", "var="} {
+		if _, err := parseIdentPatterns(arg); err == nil {
+			t.Errorf("parseIdentPatterns(%q) gave no error", arg)
+		}
+	}
+}
+
+func TestIdentCollisions(t *testing.T) {
+	goTmpl := &stagTemplate{kind: "stag", ext: "go"}
+	user := &model.File{BasePath: "/proj/models/user.go", PkgPath: "example.com/models", PkgName: "models"}
+	admin := &model.File{BasePath: "/proj/models/admin.go", PkgPath: "example.com/models", PkgName: "models"}
+	other := &model.File{BasePath: "/proj/other/user.go", PkgPath: "example.com/other", PkgName: "other"}
+	gen := func(f *model.File, tag, src string) *generator {
+		g := &generator{file: f, tag: tag, tmpl: goTmpl}
+		g.buf.WriteString("package " + f.PkgName + "\n\n" + src)
+		return g
+	}
+	pkgIdents := map[string]map[string]bool{"example.com/models": {"User": true, "UserFields": true}}
+
+	tests := []struct {
+		name string
+		gens map[string][]*generator
+		want []string
+	}{
+		{
+			name: "distinct",
+			gens: map[string][]*generator{"json": {gen(user, "json", "var User_JSON = 1\nfunc IsValidUser_JSONField() {}\n"), gen(other, "json", "var UserFields = 1\n")}},
+			want: []string{},
+		},
+		{
+			name: "declared in the pkg",
+			gens: map[string][]*generator{"json": {gen(user, "json", "var UserFields = 1\n")}},
+			want: []string{"UserFields generated for user.go (json, template stag) is already declared in pkg models"},
+		},
+		{
+			name: "generated twice in a pkg",
+			gens: map[string][]*generator{
+				"json": {gen(user, "json", "var Names = 1\n")},
+				"db":   {gen(admin, "db", "type (\n\tNames int\n)\n")},
+			},
+			want: []string{"Names generated for admin.go (db, template stag) is also generated for user.go (json, template stag)"},
+		},
+		{
+			name: "repeated field of a struct",
+			gens: map[string][]*generator{"json": {gen(user, "json", "var User_JSON = struct {\n\tName string\n\tName string\n}{}\n")}},
+			want: []string{"field Name is generated more than once in a struct for user.go (json, template stag)"},
+		},
+		{
+			name: "methods and blank vars don't collide",
+			gens: map[string][]*generator{"json": {gen(user, "json", "type T int\n\nfunc (T) User() {}\n\nvar _ = 1\nvar _ = 2\n")}},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		got := []string{}
+		for _, err := range identCollisions([]string{"json", "db"}, tt.gens, pkgIdents) {
+			got = append(got, err.Error())
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: identCollisions() = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -1,9 +1,10 @@
 package main
 
 import (
-	"go/ast"
+	"fmt"
 	"go/token"
-	"log"
+	"go/types"
+	"sort"
 
 	"github.com/bradleygore/go-stag/model"
 )
@@ -11,64 +12,391 @@ import (
 type pkgImport struct {
 	path          string
 	fs            *token.FileSet
-	pkg           *ast.Package
-	files         model.Files
-	structsByName map[string]model.Structure
+	pkg           *types.Package
+	qualifier     types.Qualifier // qualifies field types relative to the processed pkgs
+	parsed        model.Files     // the files parsed from source, whose structs are used as they are
+	fieldDocs     *fieldDocs
+	structsByName map[string]*model.Structure
 }
 
-func (i *pkgImport) loadStruct(name string) *model.Structure {
+// loadStruct returns the named struct: as parsed from source when the pkg was, so it comes with its
+// docs, directives and policies, or else built from the type-checked pkg.
+func (i *pkgImport) loadStruct(name string) (*model.Structure, error) {
+	if s := i.parsed.FindStructIn(i.path, name); s != nil {
+		return s, nil
+	}
 	if i.structsByName == nil {
-		i.structsByName = make(map[string]model.Structure)
+		i.structsByName = make(map[string]*model.Structure)
 	}
-
 	if s, exists := i.structsByName[name]; exists {
-		return &s
-	}
-
-	for fileName := range i.pkg.Files {
-		f := i.pkg.Files[fileName]
-		if i.fileContainsStruct(name, f) {
-			v := visitor{file: &model.File{PkgName: i.pkg.Name}}
-			v.processFile(v.file, f)
-			i.files = append(i.files, v.file)
-			if s := v.file.Structs.ByName(name); s != nil {
-				i.structsByName[name] = *s
-				return s
+		return s, nil
+	}
+	s, err := i.buildStruct(name)
+	if s != nil {
+		i.structsByName[name] = s
+	}
+	return s, err
+}
+
+// loadType returns the struct t refers to, when it is a (pointer to a) named struct of the pkg. An instantiated
+// generic struct is built with its type arguments substituted, i.e. Page[User] having Items []User.
+func (i *pkgImport) loadType(t types.Type) (*model.Structure, error) {
+	named := namedOf(t)
+	if named == nil {
+		return nil, nil
+	}
+	if named.TypeArgs().Len() == 0 {
+		return i.loadStruct(named.Obj().Name())
+	}
+	key := types.TypeString(named, nil)
+	if i.structsByName == nil {
+		i.structsByName = make(map[string]*model.Structure)
+	}
+	if s, exists := i.structsByName[key]; exists {
+		return s, nil
+	}
+	s, err := i.structOf(named.Obj().Name(), named)
+	if s != nil {
+		i.structsByName[key] = s
+	}
+	return s, err
+}
+
+// loadEmbed returns the struct embedded by ie, by its type when that's known so type arguments are kept
+func (i *pkgImport) loadEmbed(ie model.ImportEmbed) (*model.Structure, error) {
+	if ie.Type != nil {
+		return i.loadType(ie.Type)
+	}
+	return i.loadStruct(ie.StructName)
+}
+
+// loadNested returns the struct of ftn's type, by its type when that's known so type arguments are kept
+func (i *pkgImport) loadNested(ftn model.FieldTagName) (*model.Structure, error) {
+	if ftn.NestedType != nil {
+		return i.loadType(ftn.NestedType)
+	}
+	return i.loadStruct(ftn.NestedName)
+}
+
+// buildStruct builds the named struct from the type-checked pkg
+func (i *pkgImport) buildStruct(name string) (*model.Structure, error) {
+	obj, ok := i.pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return nil, nil
+	}
+	return i.structOf(name, obj.Type())
+}
+
+// structOf builds the struct named name out of t; field tags are carried on types.Struct, so the
+// imported pkg's source is only parsed for the docs and directives of its fields.
+func (i *pkgImport) structOf(name string, t types.Type) (*model.Structure, error) {
+	struc, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return nil, nil
+	}
+
+	s := &model.Structure{Name: name, PkgPath: i.path}
+	// an instantiated generic has its type params substituted by its type args
+	if named, ok := t.(*types.Named); ok && named.TypeArgs().Len() == 0 {
+		for idx := 0; idx < named.TypeParams().Len(); idx++ {
+			s.TypeParams = append(s.TypeParams, named.TypeParams().At(idx).Obj().Name())
+		}
+	}
+	for idx := 0; idx < struc.NumFields(); idx++ {
+		field := struc.Field(idx)
+		tag := struc.Tag(idx)
+		fieldName := field.Name()
+		// an embed of a type other than a struct is a regular field named after its type
+		embedded := false
+		if field.Embedded() {
+			// every embed is recorded by pkg path, as structs loaded here are never among the processed files
+			if embedPkgPath, embedName := structRefOf(field.Type()); embedPkgPath != "" {
+				embedded = true
+				s.ImportEmbeds = append(s.ImportEmbeds, model.ImportEmbed{
+					PkgName:               i.pkg.Name(),
+					FullyQualifiedPkgName: embedPkgPath,
+					StructName:            embedName,
+					Type:                  field.Type(),
+				})
+				fieldName = embedName
+				s.AddEmbedIndex(embedName, idx+1)
+			}
+		}
+		nestedPkgPath, nestedName := structRefOf(field.Type())
+		nestedType := nestedTypeOf(field.Type())
+		fieldType := fieldTypeOf(field.Type(), i.qualifier)
+		// a field keeps its doc and directives, whether it's declared in a parsed file, i.e. of a defined type like
+		// `type Admin User`, or in an imported pkg
+		parsed, err := i.fieldDocs.at(i.fs.Position(field.Pos()))
+		if err != nil {
+			return nil, err
+		}
+		goField := model.FieldTagName{
+			FieldName:     fieldName,
+			TagName:       fieldName,
+			Position:      i.fs.Position(field.Pos()),
+			Type:          fieldType,
+			Doc:           parsed.Doc,
+			Directives:    parsed.Directives,
+			Index:         idx + 1,
+			Embedded:      embedded,
+			NestedPkgPath: nestedPkgPath,
+			NestedName:    nestedName,
+			NestedType:    nestedType,
+		}
+		if embedded {
+			s.Embeds = append(s.Embeds, goField)
+		} else {
+			s.Fields = append(s.Fields, goField)
+		}
+		tags, err := visitor{}.parseFieldTag(tag, fieldName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: field %s.%s: %w", i.fs.Position(field.Pos()), name, fieldName, err)
+		}
+		// a //stag:name directive names the field for a tag it doesn't carry
+		for tagName := range parsed.Directives.Names {
+			if _, exists := tags[tagName]; !exists {
+				tags[tagName] = model.FieldTagName{FieldName: fieldName, TagName: fieldName}
 			}
-			log.Fatalf("Unable to find struct %s in file %s from pkg %s after parsing", name, fileName, i.pkg.Name)
 		}
+		for tagName, ftn := range tags {
+			ftn.Index = idx + 1
+			ftn.Position = i.fs.Position(field.Pos())
+			ftn.NestedPkgPath, ftn.NestedName, ftn.NestedType = nestedPkgPath, nestedName, nestedType
+			ftn.Type = fieldType
+			ftn.Doc, ftn.Directives = parsed.Doc, parsed.Directives
+			ftn.Embedded = embedded
+			s.AddFieldTag(tagName, ftn)
+		}
+	}
+
+	return s, nil
+}
+
+// structRefOf returns the pkg path and name of t when it is a named struct or a pointer to one
+func structRefOf(t types.Type) (pkgPath, name string) {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return "", ""
+	}
+	if _, ok := named.Underlying().(*types.Struct); !ok {
+		return "", ""
+	}
+	return named.Obj().Pkg().Path(), named.Obj().Name()
+}
+
+// namedOf returns the named type t is, or points to
+func namedOf(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, _ := t.(*types.Named)
+	return named
+}
+
+// nestedTypeOf returns t when it is a (pointer to a) named struct, so the struct can be loaded with any type arguments
+func nestedTypeOf(t types.Type) types.Type {
+	if pkgPath, _ := structRefOf(t); pkgPath == "" {
+		return nil
 	}
+	return t
+}
 
-	return nil
+// isInstance reports whether t is, or points to, an instantiated generic type
+func isInstance(t types.Type) bool {
+	named := namedOf(t)
+	return named != nil && named.TypeArgs().Len() > 0
 }
 
-func (i *pkgImport) fileContainsStruct(name string, f *ast.File) bool {
-	for idx := range f.Decls {
-		d := f.Decls[idx]
-		switch decNode := d.(type) {
-		case *ast.GenDecl:
-			for _, spec := range decNode.Specs {
-				switch node := spec.(type) {
-				case *ast.TypeSpec:
-					if _, ok := node.Type.(*ast.StructType); ok {
-						if node.Name.String() == name {
-							return true
-						}
-					}
-				}
+// fieldTypeOf describes t, qualifying pkgs as qualifier does
+func fieldTypeOf(t types.Type, qualifier types.Qualifier) model.FieldType {
+	ft := model.FieldType{Expr: types.TypeString(t, qualifier)}
+	switch t.Underlying().(type) {
+	case *types.Pointer:
+		ft.Kind, ft.Nullable = model.KindPointer, true
+	case *types.Slice:
+		ft.Kind, ft.Nullable = model.KindSlice, true
+	case *types.Map:
+		ft.Kind, ft.Nullable = model.KindMap, true
+	case *types.Interface:
+		ft.Kind, ft.Nullable = model.KindInterface, true
+		if _, ok := t.(*types.TypeParam); ok {
+			ft.Kind, ft.Nullable = model.KindTypeParam, false
+		}
+	case *types.Signature:
+		ft.Kind, ft.Nullable = model.KindFunc, true
+	case *types.Chan:
+		ft.Kind, ft.Nullable = model.KindChan, true
+	case *types.Array:
+		ft.Kind = model.KindArray
+	case *types.Struct:
+		ft.Kind = model.KindStruct
+	default:
+		ft.Kind = model.KindBasic
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if named, ok := t.(*types.Named); ok && named.Obj().Pkg() != nil {
+		ft.PkgPath = named.Obj().Pkg().Path()
+	}
+	return ft
+}
+
+// qualifierFor qualifies types by pkg name, except those of the pkgs at pkgPaths
+func qualifierFor(pkgPaths ...string) types.Qualifier {
+	return func(p *types.Package) string {
+		for _, path := range pkgPaths {
+			if p.Path() == path {
+				return ""
 			}
 		}
+		return p.Name()
+	}
+}
+
+// pkgImports hands out a pkgImport for any pkg reachable from the loaded pkgs, creating them as they're needed
+type pkgImports struct {
+	fs        *token.FileSet
+	rootPaths []string
+	parsed    model.Files
+	fieldDocs *fieldDocs
+	typesPkgs map[string]*types.Package
+	byPath    map[string]*pkgImport
+}
+
+func newPkgImports(fs *token.FileSet, roots []*types.Package, parsed model.Files) *pkgImports {
+	pi := &pkgImports{fs: fs, parsed: parsed, fieldDocs: newFieldDocs(parsed), typesPkgs: make(map[string]*types.Package), byPath: make(map[string]*pkgImport)}
+	// the first root of a path wins over any other, and over the variants its imports reach
+	for _, root := range roots {
+		if _, exists := pi.typesPkgs[root.Path()]; !exists {
+			pi.rootPaths = append(pi.rootPaths, root.Path())
+			pi.typesPkgs[root.Path()] = root
+		}
+	}
+	for _, root := range roots {
+		for _, imp := range root.Imports() {
+			pi.collect(imp)
+		}
+	}
+	return pi
+}
+
+func (pi *pkgImports) collect(pkg *types.Package) {
+	if _, exists := pi.typesPkgs[pkg.Path()]; exists {
+		return
+	}
+	pi.typesPkgs[pkg.Path()] = pkg
+	for _, imp := range pkg.Imports() {
+		pi.collect(imp)
+	}
+}
+
+func (pi *pkgImports) ByPath(path string) *pkgImport {
+	if imp, exists := pi.byPath[path]; exists {
+		return imp
+	}
+	typesPkg, exists := pi.typesPkgs[path]
+	if !exists {
+		return nil
+	}
+	imp := &pkgImport{path: path, fs: pi.fs, pkg: typesPkg, qualifier: qualifierFor(pi.rootPaths...), parsed: pi.parsed, fieldDocs: pi.fieldDocs}
+	pi.byPath[path] = imp
+	return imp
+}
+
+// resolveEmbeds loads the struct behind every imported embed of s, then every embed of those, and so on.
+// Embeds already resolved are left alone, which is also what ends the walk on pointer embed cycles.
+func (pi *pkgImports) resolveEmbeds(s *model.Structure) []error {
+	errs := []error{}
+	for idx := range s.ImportEmbeds {
+		ie := &s.ImportEmbeds[idx]
+		if ie.Struct != nil {
+			continue
+		}
+		imp := pi.ByPath(ie.FullyQualifiedPkgName)
+		if imp == nil {
+			errs = append(errs, fmt.Errorf("unable to find import %s for embed %s in struct %s", ie.FullyQualifiedPkgName, ie.StructName, s.Name))
+			continue
+		}
+		embStruct, err := imp.loadEmbed(*ie)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if embStruct == nil {
+			errs = append(errs, fmt.Errorf("could not find struct by name %s in pkg %s", ie.StructName, ie.FullyQualifiedPkgName))
+			continue
+		}
+		ie.Struct = embStruct
+		errs = append(errs, pi.resolveEmbeds(embStruct)...)
 	}
-	return false
+	return errs
 }
 
-type pkgImports []pkgImport
+// resolveNested loads the struct behind every field whose type is a named struct, recursively.
+// seen holds the structs on the current path so self-referencing types stop instead of looping.
+func (pi *pkgImports) resolveNested(s *model.Structure, seen map[*model.Structure]bool) []error {
+	errs := []error{}
+	seen[s] = true
+	defer delete(seen, s)
+	allFields := []model.FieldTagNames{s.Fields}
+	for _, fields := range s.FieldTagNames {
+		allFields = append(allFields, fields)
+	}
+	for _, fields := range allFields {
+		for idx := range fields {
+			ftn := &fields[idx]
+			if ftn.NestedName == "" || ftn.Nested != nil {
+				continue
+			}
+			imp := pi.ByPath(ftn.NestedPkgPath)
+			if imp == nil {
+				errs = append(errs, fmt.Errorf("%s: unable to find pkg %s for field %s.%s", ftn.Position, ftn.NestedPkgPath, s.Name, ftn.FieldName))
+				continue
+			}
+			nested, err := imp.loadNested(*ftn)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			ftn.Nested = nested
+			if nested != nil && !seen[nested] {
+				errs = append(errs, pi.resolveEmbeds(nested)...)
+				errs = append(errs, pi.resolveNested(nested, seen)...)
+			}
+		}
+	}
+	// local embeds are among the processed files, and resolved along with them
+	for _, ie := range s.ImportEmbeds {
+		if ie.Struct != nil && !seen[ie.Struct] {
+			errs = append(errs, pi.resolveNested(ie.Struct, seen)...)
+		}
+	}
+	return errs
+}
 
-func (pi pkgImports) ByPath(path string) *pkgImport {
-	for _, i := range pi {
-		if i.path == path {
-			return &i
+// Built returns every struct built from the type-checked pkgs rather than parsed from source, so their
+// embeds are joined along with those of the parsed ones
+func (pi *pkgImports) Built() model.Structures {
+	paths := []string{}
+	for path := range pi.byPath {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	built := model.Structures{}
+	for _, path := range paths {
+		names := []string{}
+		for name := range pi.byPath[path].structsByName {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			built = append(built, pi.byPath[path].structsByName[name])
 		}
 	}
-	return nil
+	return built
 }
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const loadMode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedSyntax |
+	packages.NeedTypes |
+	packages.NeedTypesInfo |
+	packages.NeedImports |
+	packages.NeedModule |
+	packages.NeedForTest
+
+// loadTargets says which of the loaded files were asked to be generated for
+type loadTargets struct {
+	files map[string]bool // single files given as sources, by abs path
+	pkgs  map[string]bool // pkgs matched by dir or pattern sources, by pkg path
+}
+
+func (t loadTargets) includes(pkgPath, filePath string) bool {
+	return t.pkgs[pkgPath] || t.files[filePath]
+}
+
+// loadPackages loads the pkgs for all sources at once through the go command, so module replace
+// directives, vendor dirs and workspaces resolve exactly as they would for a build, and pkgs
+// imported by several sources are only loaded once. Sources are .go files, dirs, or go patterns
+// like ./... and import paths. A single .go file loads its whole package, since a file can't be
+// type-checked on its own. With tests, each pkg also loads as its test variant, along with any
+// external _test pkg.
+func loadPackages(sources []string) ([]*packages.Package, loadTargets, error) {
+	targets := loadTargets{files: make(map[string]bool), pkgs: make(map[string]bool)}
+	cfg := &packages.Config{Mode: loadMode, Env: os.Environ(), Tests: *withTests}
+	// the build context decides which files make up each pkg, i.e. //go:build lines and _linux.go suffixes
+	if *goos != "" {
+		cfg.Env = append(cfg.Env, "GOOS="+*goos)
+	}
+	if *goarch != "" {
+		cfg.Env = append(cfg.Env, "GOARCH="+*goarch)
+	}
+	if *buildTags != "" {
+		cfg.BuildFlags = append(cfg.BuildFlags, "-tags="+*buildTags)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, targets, err
+	}
+	filePatterns, pkgPatterns := []string{}, []string{}
+	for _, source := range sources {
+		absSource, err := filepath.Abs(source)
+		if err != nil {
+			return nil, targets, err
+		}
+		if rxIsGoFile.MatchString(absSource) {
+			targets.files[absSource] = true
+			filePatterns = append(filePatterns, "file="+absSource)
+			continue
+		}
+		if fi, err := os.Stat(absSource); err == nil && fi.IsDir() {
+			// dirs are given to the go command relative to where it runs, as a bare name would read as an import path
+			if rel, err := filepath.Rel(cwd, absSource); err == nil && !strings.HasPrefix(rel, "..") {
+				source = "./" + filepath.ToSlash(rel)
+			} else {
+				source = absSource
+			}
+		}
+		pkgPatterns = append(pkgPatterns, source)
+	}
+	// a lone file or dir runs the go command from there, so it may lie in another module than the working dir
+	if len(sources) == 1 {
+		if len(filePatterns) == 1 {
+			cfg.Dir = filepath.Dir(sources[0])
+		} else if fi, err := os.Stat(sources[0]); err == nil && fi.IsDir() {
+			cfg.Dir, pkgPatterns = sources[0], []string{"."}
+		}
+	}
+
+	pkgs, err := packages.Load(cfg, append(filePatterns, pkgPatterns...)...)
+	if err != nil {
+		return nil, targets, err
+	}
+
+	if len(pkgPatterns) > 0 {
+		matched := pkgs
+		if len(filePatterns) > 0 {
+			// only which pkgs the patterns match is needed, so there's no parsing or type-checking here
+			namesCfg := *cfg
+			namesCfg.Mode = packages.NeedName
+			if matched, err = packages.Load(&namesCfg, pkgPatterns...); err != nil {
+				return nil, targets, err
+			}
+		}
+		for _, pkg := range matched {
+			targets.pkgs[pkg.PkgPath] = true
+		}
+	}
+
+	loaded := []*packages.Package{}
+	for _, pkg := range pkgs {
+		// the synthesized main pkg running the tests has nothing of ours in it
+		if *withTests && strings.HasSuffix(pkg.PkgPath, ".test") {
+			continue
+		}
+		loaded = append(loaded, pkg)
+		for _, pkgErr := range pkg.Errors {
+			switch pkgErr.Kind {
+			case packages.TypeError:
+				// stale generated files commonly fail to type-check; the structs we need are still usable
+				if *verbose {
+					fmt.Printf("warning: %s\n", pkgErr)
+				}
+			default:
+				return nil, targets, fmt.Errorf("loading %s: %s", pkg.PkgPath, pkgErr)
+			}
+		}
+	}
+
+	return loaded, targets, nil
+}
@@ -16,53 +16,56 @@
 //
 // Given a struct like:
 //
-//    type Foo struct {
-//       Name string `json:"theName" db:"the_name"`
-//       Flavor string `json:"yummyFlavor" db:"mmm_flavor"`
-//    }
+//	type Foo struct {
+//	   Name string `json:"theName" db:"the_name"`
+//	   Flavor string `json:"yummyFlavor" db:"mmm_flavor"`
+//	}
 //
 // A cmd of
-//   stag -source=path/to/file.go -tags=json,db
+//
+//	stag -source=path/to/file.go -tags=json,db
+//
 // would produce two files:
 //   - path/to/file.stag_json.go
 //   - path/to/file.stag_db.go
 //
 // With the output being:
-//    //file.stag_json.go
-//    var Foo_JSON = struct{
-//        Name string
-//        Flavor string
-//    }{
-//        Name: "theName",
-//        Flavor: "yummyFlavor",
-//    }
 //
-//    //file.stag_db.go
-//    var Foo_DB = struct{
-//        Name string
-//        Flavor string
-//    }{
-//        Name: "the_name",
-//        Flavor: "mmm_flavor",
-//    }
+//	//file.stag_json.go
+//	var Foo_JSON = struct{
+//	    Name string
+//	    Flavor string
+//	}{
+//	    Name: "theName",
+//	    Flavor: "yummyFlavor",
+//	}
+//
+//	//file.stag_db.go
+//	var Foo_DB = struct{
+//	    Name string
+//	    Flavor string
+//	}{
+//	    Name: "the_name",
+//	    Flavor: "mmm_flavor",
+//	}
 package main
 
 // Order of features to tackle:
 //TODO(BDG): Support single file target
 //TODO(BDG): Support embedded struct fields
-//TODO(BDG): Support pkg or single file target, specifying types (inferring files if in pkg mode)
 //TODO(BDG): Copyright file
 
 import (
 	"flag"
 	"fmt"
 	"go/ast"
-	"go/build"
-	"go/parser"
-	"go/token"
+	"go/types"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/bradleygore/go-stag/model"
@@ -70,12 +73,27 @@ import (
 
 // cmd flags
 var (
-	source      = flag.String("source", "", "singular source file or directory to process")
-	tagsArg     = flag.String("tags", "", "comma-separated set of tags to acquire static naming for")
-	outType     = flag.String("out", "file", "output type; file | stdout; defaults to file (remains as file for pkg-wide processing)")
-	showVersion = flag.Bool("version", false, "Print version.")
-	showHelp    = flag.Bool("help", false, "show help")
-	verbose     = flag.Bool("v", false, "verbose output")
+	sources      stringList
+	templateArgs stringList
+	typesArg     = flag.String("types", "", "comma-separated struct names to generate for, as globs (User*) or /regexps/; defaults to all")
+	skipTypes    = flag.String("excludetypes", "", "comma-separated struct names not to generate for, as globs or /regexps/")
+	excludeArg   = flag.String("exclude", "", "comma-separated globs of files or dirs to not generate for, i.e. testdata,vendor,*_mock.go")
+	tagsArg      = flag.String("tags", "", "comma-separated set of tags to acquire static naming for")
+	outType      = flag.String("out", "file", "output type; file | stdout; defaults to file (remains as file for pkg-wide processing)")
+	showVersion  = flag.Bool("version", false, "Print version.")
+	showHelp     = flag.Bool("help", false, "show help")
+	verbose      = flag.Bool("v", false, "verbose output")
+	nested       = flag.Bool("nested", false, "generate fields having a tagged struct type as nested objects holding full paths")
+	inlineArg    = flag.String("inline", "", "comma-separated tag=option pairs, adding tag families whose option inlines a struct field's fields into its parent")
+	policyArg    = flag.String("policy", "", "semicolon-separated per-tag policies for untagged fields, i.e. db:untagged=true,unexported=false,naming=snake; naming is one of go | lower | snake | camel | kebab | pascal")
+	withTests    = flag.Bool("tests", false, "also process _test.go files, generating into _test.go files of the same (internal or external test) pkg")
+	goos         = flag.String("goos", "", "GOOS to load sources for; defaults to the go env")
+	goarch       = flag.String("goarch", "", "GOARCH to load sources for; defaults to the go env")
+	buildTags    = flag.String("buildtags", "", "comma-separated build tags to load sources with")
+	pathSep      = flag.String("sep", ".", "separator joining nested field paths; i.e. . for json/mongo paths or / for JSON Pointer")
+	identsArg    = flag.String("idents", "", "semicolon-separated kind=template pairs naming generated identifiers, i.e. var={{.Struct}}{{pascal .Tag}}Fields; kinds are var | allFieldNames | isValid | fields | options | hasOption | allGoFieldNames | goNameFor | tagNameFor | type | const | values | parse (of .Struct, .Tag and .Field), and helpers upper | lower | pascal | camel | snake | kebab")
+	styleArg     = flag.String("style", "var", "output style when no -template is given; var for a struct var holding the names, or const for a string type having a const per field")
+	configArg    = flag.String("config", "", "project config file; defaults to stag.yaml, stag.yml or .stag.json at the module root, none to not use one")
 )
 
 // regex
@@ -84,6 +102,27 @@ var (
 	rxIsStagFile = regexp.MustCompile(".stag-.*.go$")
 )
 
+func init() {
+	flag.Var(&templateArgs, "template", "built-in template name (stag | const | columns | docs), template file, or dir of .tmpl files to generate with; may be repeated or comma-separated; defaults to stag")
+	flag.Var(&sources, "source", "source file, directory, or go package pattern (i.e. ./... or an import path) to process; may be repeated or comma-separated")
+}
+
+// stringList is a flag which may be given several times, each holding one or more comma-separated values
+type stringList []string
+
+func (sl *stringList) String() string {
+	return strings.Join(*sl, ",")
+}
+
+func (sl *stringList) Set(val string) error {
+	for _, v := range strings.Split(val, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			*sl = append(*sl, v)
+		}
+	}
+	return nil
+}
+
 func main() {
 	flag.Usage = func() {
 		printUsage(true)
@@ -100,143 +139,323 @@ func main() {
 		return
 	}
 
+	gogen, underGoGenerate := goGenerateEnv()
+
+	// flags given on the cmd line win over the config
+	givenFlags := map[string]bool{}
+	flag.Visit(func(f *flag.Flag) {
+		givenFlags[f.Name] = true
+	})
+	// under go generate, the file holding the //go:generate line is the source rather than those of the config
+	givenFlags["source"] = givenFlags["source"] || underGoGenerate
+	cfgPath := *configArg
+	if cfgPath == "" {
+		if cwd, err := os.Getwd(); err == nil {
+			cfgPath = findConfig(cwd)
+		}
+	} else if cfgPath == "none" {
+		cfgPath = ""
+	}
+	cfg, err := loadConfig(cfgPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := cfg.applyFlags(givenFlags); err != nil {
+		log.Fatal(err)
+	}
+	if cfgPath != "" && *verbose {
+		fmt.Println("config: ", cfgPath)
+	}
+
 	if len(*tagsArg) == 0 {
 		printUsage(true)
 		log.Fatal("tags is required")
 	}
 	tags := strings.Split(*tagsArg, ",")
 
-	if *source == "" {
+	// under go generate, the file holding the //go:generate line is the default source
+	fromGoGenerate := underGoGenerate && len(sources) == 0
+	if fromGoGenerate {
+		sources = stringList{gogen.file}
+		// a _test.go file only loads along with the tests
+		if strings.HasSuffix(gogen.file, "_test.go") {
+			*withTests = true
+		}
+	}
+
+	if len(sources) == 0 {
 		printUsage(true)
 		log.Fatal("source is required")
 	}
 
-	files := model.Files{}
-	fs := token.NewFileSet()
-
-	if rxIsGoFile.MatchString(*source) {
-		if rxIsStagFile.MatchString(*source) {
-			log.Fatal("cannot process a stag-generated file")
+	// a style picks the built-in template to generate with
+	switch *styleArg {
+	case "var":
+	case "const":
+		if len(templateArgs) > 0 {
+			log.Fatal("style const can't be combined with -template; give the const template along with the others instead")
 		}
+		templateArgs = stringList{"const"}
+	default:
+		log.Fatalf("unknown style %q; one of var | const", *styleArg)
+	}
+	templates, err := loadTemplates(templateArgs)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		f, err := parser.ParseFile(fs, *source, nil, parser.AllErrors)
+	identPatterns, err := parseIdentPatterns(*identsArg)
+	if err == nil {
+		_, err = parseIdentTemplates(nil, identPatterns)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		if err != nil {
+	if len(*policyArg) > 0 {
+		if err := parsePolicies(*policyArg); err != nil {
 			log.Fatal(err)
 		}
+	}
+
+	if len(*inlineArg) > 0 {
+		for _, pair := range strings.Split(*inlineArg, ",") {
+			eq := strings.IndexRune(pair, '=')
+			if eq <= 0 || eq == len(pair)-1 {
+				log.Fatalf("inline must be given as tag=option pairs, got %q", pair)
+			}
+			model.InlineOptions[pair[:eq]] = pair[eq+1:]
+		}
+	}
 
-		vis := visitor{}
-		ast.Walk(vis, f)
-		if vis.file != nil {
-			vis.file.BasePath = *source
-			files = append(files, vis.file)
+	for _, source := range sources {
+		if rxIsGoFile.MatchString(source) && rxIsStagFile.MatchString(source) {
+			log.Fatal("cannot process a stag-generated file")
 		}
-	} else {
-		pkgs, err := parser.ParseDir(fs, *source, func(fi os.FileInfo) bool {
-			return !rxIsStagFile.MatchString(fi.Name())
-		}, parser.AllErrors)
+	}
 
-		if err != nil {
+	filter, err := newTypeFilter(*typesArg, *skipTypes)
+	if err != nil {
+		log.Fatal(err)
+	}
+	goGenFilePath := ""
+	goGenTypes := map[*model.File][]string{} // types generated for by a //go:generate line directly above them
+	if fromGoGenerate {
+		if goGenFilePath, err = filepath.Abs(gogen.file); err != nil {
 			log.Fatal(err)
 		}
+	}
+
+	excludes := []string{}
+	if len(*excludeArg) > 0 {
+		excludes = strings.Split(*excludeArg, ",")
+	}
+
+	// the whole pkg of a single source file is loaded for type-checking and resolution, but only that file is generated for
+	pkgs, targets, err := loadPackages(sources)
+	if err != nil {
+		log.Fatal(err)
+	}
 
-		for pkgName, pkg := range pkgs {
-			fmt.Println("pkg: ", pkgName)
-			for filePath, file := range pkg.Files {
+	files := model.Files{}
+	allFiles := model.Files{} // includes sibling files of single source files and excluded files, for resolving embeds
+	errs := []error{}
+	typesPkgs := []*types.Package{}
+	seenFiles := map[string]bool{}
+	pkgIdents := map[string]map[string]bool{} // identifiers declared at pkg level, by pkg path
+	dirCfgs := map[*model.File]dirConfig{}    // the config's overrides for each file's dir
+
+	for _, pkg := range pkgs {
+		fmt.Println("pkg: ", pkg.PkgPath)
+		if pkg.ForTest == pkg.PkgPath {
+			// a pkg's test variant goes first, as it also declares the types of the pkg's internal _test.go files
+			typesPkgs = append([]*types.Package{pkg.Types}, typesPkgs...)
+		} else {
+			typesPkgs = append(typesPkgs, pkg.Types)
+		}
+		pkgIdents[pkg.PkgPath] = declaredIdents(pkg, pkgIdents[pkg.PkgPath])
+		for _, file := range pkg.Syntax {
+			filePath := pkg.Fset.Position(file.Pos()).Filename
+			// test variants of a pkg repeat its non-test files
+			if rxIsStagFile.MatchString(filePath) || seenFiles[filePath] {
+				continue
+			}
+			seenFiles[filePath] = true
+			vis := visitor{file: &model.File{BasePath: filePath, PkgPath: pkg.PkgPath, BuildConstraint: buildConstraintOf(filePath, file)}, info: pkg.TypesInfo, fset: pkg.Fset, errs: &errs}
+			ast.Walk(vis, file)
+			if filePath == goGenFilePath {
+				if vis.file.PkgName != gogen.pkgName {
+					log.Fatalf("%s is in pkg %s rather than $GOPACKAGE %s", filePath, vis.file.PkgName, gogen.pkgName)
+				}
+				// a //go:generate line directly above a type generates only that type
+				if names := gogen.typesBelow(pkg.Fset, file); names != nil {
+					filter.names = make(map[string]bool)
+					for _, name := range names {
+						filter.names[name] = true
+					}
+					goGenTypes[vis.file] = names
+				}
+			}
+			dirCfg := cfg.forDir(filepath.Dir(filePath))
+			dirCfgs[vis.file] = dirCfg
+			if policies := dirCfg.policies(); policies != nil {
+				for _, s := range vis.file.Structs {
+					s.Policies = policies
+				}
+			}
+			allFiles = append(allFiles, vis.file)
+			if targets.includes(pkg.PkgPath, filePath) && !isExcluded(filePath, excludes) && !dirCfg.Skip {
 				fmt.Println("\t-" + filePath)
-				vis := visitor{file: &model.File{BasePath: filePath}}
-				ast.Walk(vis, file)
 				files = append(files, vis.file)
 			}
 		}
 	}
+	exitOnErrors(errs)
 
-	if len(files) == 0 {
-		fmt.Print("no files needed processing")
-		return
+	// the tags and types each file is generated for, from the cmd line or its dir's config
+	fileTags := map[*model.File][]string{}
+	fileFilters := map[*model.File]typeFilter{}
+	for _, f := range files {
+		fileTags[f] = tags
+		if dirTags := dirCfgs[f].Tags; len(dirTags) > 0 && !givenFlags["tags"] {
+			fileTags[f] = dirTags
+		}
+		fileFilters[f] = filter
+		if dirCfg := dirCfgs[f]; (len(dirCfg.Types) > 0 || len(dirCfg.ExcludeTypes) > 0) && !givenFlags["types"] && !givenFlags["excludetypes"] {
+			// validated along with the config
+			dirFilter, _ := newTypeFilter(strings.Join(dirCfg.Types, ","), strings.Join(dirCfg.ExcludeTypes, ","))
+			dirFilter.names = filter.names
+			fileFilters[f] = dirFilter
+		}
 	}
-
-	// grab all imports as *ast.Pkg
-	imports := pkgImports{}
-
-	// update file structs imports with fully qualified paths
+	// //stag:generate directives may ask for tags beyond those
+	genTags, seenTags := []string{}, map[string]bool{}
+	addTags := func(ts []string) {
+		for _, tag := range ts {
+			if !seenTags[tag] {
+				seenTags[tag] = true
+				genTags = append(genTags, tag)
+			}
+		}
+	}
+	addTags(tags)
 	for _, f := range files {
+		addTags(fileTags[f])
 		for _, s := range f.Structs {
-			for idx := range s.ImportEmbeds {
-				ie := &s.ImportEmbeds[idx]
-				if imp := f.Imports.ByAlias(ie.PkgName); imp != nil {
-					ie.FullyQualifiedPkgName = imp.PkgPath
-				}
-			}
+			addTags(s.Directives.Generate)
 		}
 	}
 
-	embedPkgs := files.EmbeddedImportPkgNames() // these are unique already
-	for pidx := range embedPkgs {
-		imp := pkgImport{
-			path: embedPkgs[pidx],
-			fs:   token.NewFileSet(),
-		}
-		fmt.Println("Processing imported pkg: ", imp.path)
-		var pkgs map[string]*ast.Package
-		if buildPkg, err := build.Import(imp.path, files[0].BaseDir(), build.FindOnly); err != nil {
-			log.Fatalf("Error finding pkg dir for %s: %s", imp.path, err.Error())
-		} else if pkgs, err = parser.ParseDir(imp.fs, buildPkg.Dir, func(fi os.FileInfo) bool {
-			return !rxIsStagFile.MatchString(fi.Name())
-		}, parser.AllErrors); err != nil {
-			log.Fatalf("Error parsing pkg dir of %s for %s: %s", buildPkg.Dir, imp.path, err.Error())
+	for filePath := range targets.files {
+		if !seenFiles[filePath] {
+			log.Fatalf("%s is excluded by the build constraints in effect; see -goos, -goarch and -buildtags", filePath)
 		}
+	}
 
-		for pkgName, pkg := range pkgs {
-			if strings.HasSuffix(imp.path, pkgName) {
-				imp.pkg = pkg
-				imports = append(imports, imp)
-				break
+	if len(files) == 0 {
+		fmt.Print("no files needed processing")
+		return
+	}
+
+	// every pkg reachable from the loaded pkgs, as seen by the type checker
+	imports := newPkgImports(pkgs[0].Fset, typesPkgs, allFiles)
+
+	for _, embedPkg := range allFiles.EmbeddedImportPkgNames() {
+		fmt.Println("Processing imported pkg: ", embedPkg)
+	}
+
+	for _, f := range allFiles {
+		for _, s := range f.Structs {
+			if !s.FromType {
+				continue
+			}
+			fromType, err := imports.ByPath(f.PkgPath).buildStruct(s.Name)
+			switch {
+			case err != nil:
+				errs = append(errs, err)
+			case fromType == nil:
+				errs = append(errs, fmt.Errorf("%s: unable to load the fields of %s from its type", f.BasePath, s.Name))
+			default:
+				doc, directives, policies := s.Doc, s.Directives, s.Policies
+				*s = *fromType
+				s.Doc, s.Directives, s.Policies, s.FromType = doc, directives, policies, true
 			}
 		}
+	}
 
-		if imp.pkg == nil {
-			log.Fatalf("Could not find package with path %s", imp.path)
+	for _, f := range allFiles {
+		for _, s := range f.Structs {
+			errs = append(errs, imports.resolveEmbeds(s)...)
 		}
 	}
 
+	// fields declared at the same level sharing a tag name cancel each other out, i.e. encoding/json drops both,
+	// unless only one of them is tagged, which then wins; only structs which are generated for are of concern
 	for _, f := range files {
 		for _, s := range f.Structs {
-			for idx := range s.ImportEmbeds {
-				ie := &s.ImportEmbeds[idx]
-				if imp := imports.ByPath(ie.FullyQualifiedPkgName); imp != nil {
-					if s := imp.loadStruct(ie.StructName); s != nil {
-						ie.Struct = s
-					} else {
-						log.Fatalf("Could not find struct by name %s in pkg %s", ie.StructName, ie.FullyQualifiedPkgName)
-					}
-				} else {
-					log.Fatalf("Unable to find import for %s", ie.FullyQualifiedPkgName)
+			if !fileFilters[f].matches(s.Name) {
+				continue
+			}
+			for _, tag := range genTags {
+				if !s.Directives.Generates(tag, fileTags[f]) {
+					continue
+				}
+				fields := s.FieldsFor(tag)
+				for _, dupe := range fields.Duplicates() {
+					first := fields.FirstLike(dupe)
+					errs = append(errs, fmt.Errorf("%s: field %s.%s duplicates %s name %q of field %s", dupe.Position, s.Name, dupe.FieldName, tag, dupe.TagName, first.FieldName))
 				}
 			}
 		}
 	}
+	// struct-typed fields are needed both to generate nested objects and to inline fields per tag family
+	for _, f := range allFiles {
+		for _, s := range f.Structs {
+			errs = append(errs, imports.resolveNested(s, map[*model.Structure]bool{})...)
+		}
+	}
+	exitOnErrors(errs)
+
+	allFiles.JoinEmbeds(imports.Built())
 
-	files.JoinEmbeds()
+	nestSep := "" // nesting is disabled when there's no separator
+	if *nested {
+		nestSep = *pathSep
+	}
 
 	tagGenerators := map[string][]*generator{}
-	for _, t := range tags {
+	for _, t := range genTags {
 		tagGenerators[t] = []*generator{}
 	}
 
 	for _, f := range files {
-		for _, tag := range tags {
+		for _, tag := range genTags {
 			if _, exists := tagGenerators[tag]; !exists {
 				tagGenerators[tag] = []*generator{}
 			}
-			tagGenerators[tag] = append(tagGenerators[tag], &generator{file: f, tag: tag})
+			// flags win over the config
+			idents, err := parseIdentTemplates(nil, mergeStrings(cfg.identPatterns(tag, dirCfgs[f]), identPatterns))
+			if err != nil {
+				log.Fatal(err)
+			}
+			for _, tmpl := range templates {
+				tagGenerators[tag] = append(tagGenerators[tag], &generator{file: f, tag: tag, nestSep: nestSep, filter: fileFilters[f], cmdTags: fileTags[f], idents: idents, tmpl: tmpl})
+			}
 		}
 	}
 
-	for _, tag := range tags {
+	for _, tag := range genTags {
 		fmt.Printf("Processing for tag %s...\n", tag)
 		for _, g := range tagGenerators[tag] {
 			g.Generate()
+		}
+	}
+	// nothing is written unless every generated identifier is free to be declared
+	exitOnErrors(identCollisions(genTags, tagGenerators, pkgIdents))
+
+	written := map[string]*model.File{} // the source file generated into each file
+	for _, tag := range genTags {
+		for _, g := range tagGenerators[tag] {
 			// not every file will have things we need to generate for
 			if len(g.buf.Bytes()) == 0 {
 				fmt.Printf("skipping file %s\n", g.file.BasePath)
@@ -244,7 +463,15 @@ func main() {
 			}
 			dst := os.Stdout
 			if *outType != "stdout" {
-				g.dstFileName = strings.Replace(g.file.BasePath, ".go", fmt.Sprintf(".stag-%s.go", g.tag), 1)
+				fileName := cfg.Output.FileName
+				if dirFileName := dirCfgs[g.file].FileName; dirFileName != "" {
+					fileName = dirFileName
+				}
+				g.dstFileName = templateFileName(dstFileNameFor(g.file, g.tag, fileName, goGenTypes[g.file]), g.tmpl, len(templates) > 1)
+				if writer, exists := written[g.dstFileName]; exists {
+					log.Fatalf("%s and %s would both be generated into %s", writer.BasePath, g.file.BasePath, g.dstFileName)
+				}
+				written[g.dstFileName] = g.file
 				outFile, err := os.Create(g.dstFileName)
 				if err != nil {
 					log.Fatalf("Failed opening destination file %s: %v", g.dstFileName, err)
@@ -259,3 +486,118 @@ func main() {
 		}
 	}
 }
+
+// isExcluded reports whether any glob matches the file's name, one of its dirs, or its path relative to the working dir
+func isExcluded(filePath string, globs []string) bool {
+	relPath := filePath
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(cwd, filePath); err == nil {
+			relPath = rel
+		}
+	}
+	relPath = filepath.ToSlash(relPath)
+	for _, glob := range globs {
+		glob = strings.TrimSuffix(filepath.ToSlash(strings.TrimSpace(glob)), "/")
+		if glob == "" {
+			continue
+		}
+		if ok, _ := path.Match(glob, relPath); ok {
+			return true
+		}
+		if ok, _ := path.Match(glob, path.Dir(relPath)); ok {
+			return true
+		}
+		for _, segment := range strings.Split(relPath, "/") {
+			if ok, _ := path.Match(glob, segment); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// dstFileNameFor returns the file generated for f and tag, named by pattern or else i.e. user.stag-json.go,
+// keeping output for user_test.go in a _test.go file so it is only compiled for tests. When only the types
+// below a //go:generate line are generated, their names are part of the file's, i.e. user_admin.stag-json.go,
+// so several such lines in one file don't overwrite each other's output.
+func dstFileNameFor(f *model.File, tag, pattern string, typeNames []string) string {
+	if pattern == "" {
+		pattern = defaultFileNamePattern
+	}
+	name := strings.TrimSuffix(strings.TrimSuffix(f.FileName(), ".go"), "_test")
+	if len(typeNames) > 0 {
+		name += "_" + strings.ToLower(strings.Join(typeNames, "_"))
+	}
+	fileName := strings.NewReplacer("{name}", name, "{pkg}", f.PkgName, "{tag}", tag).Replace(pattern)
+	if f.IsTest() {
+		fileName = strings.TrimSuffix(fileName, ".go") + "_test.go"
+	}
+	return filepath.Join(filepath.Dir(f.BasePath), fileName)
+}
+
+// templateFileName returns the file generated by a template in place of the Go file fileName: with the template's ext,
+// and, when several templates are generated, named after it, i.e. user.stag-db.columns.go or user.stag-db.docs.md.
+// The default template keeps fileName, so adding templates never leaves its earlier output behind.
+func templateFileName(fileName string, st *stagTemplate, several bool) string {
+	if st.isDefault || (!several && st.isGo()) {
+		return fileName
+	}
+	test := strings.HasSuffix(fileName, "_test.go")
+	base := strings.TrimSuffix(strings.TrimSuffix(fileName, ".go"), "_test")
+	if several {
+		base += "." + st.kind
+	}
+	if test {
+		base += "_test"
+	}
+	return base + "." + st.ext
+}
+
+// parsePolicies adds to, or overrides, the built-in tag policies from tag:key=value,... entries separated by semicolons.
+// Settings not given keep the tag's current policy.
+func parsePolicies(arg string) error {
+	for _, entry := range strings.Split(arg, ";") {
+		colon := strings.IndexRune(entry, ':')
+		if colon <= 0 {
+			return fmt.Errorf("policy must be given as tag:key=value,..., got %q", entry)
+		}
+		tag := entry[:colon]
+		policy := model.PolicyFor(tag)
+		for _, setting := range strings.Split(entry[colon+1:], ",") {
+			eq := strings.IndexRune(setting, '=')
+			if eq <= 0 {
+				return fmt.Errorf("policy setting must be given as key=value, got %q for tag %s", setting, tag)
+			}
+			key, val := setting[:eq], setting[eq+1:]
+			var err error
+			switch key {
+			case "untagged":
+				policy.Untagged, err = strconv.ParseBool(val)
+			case "unexported":
+				policy.Unexported, err = strconv.ParseBool(val)
+			case "naming":
+				if policy.Naming = model.Naming(val); !policy.Naming.Valid() {
+					err = fmt.Errorf("unknown naming %q", val)
+				}
+			default:
+				err = fmt.Errorf("unknown policy setting %q", key)
+			}
+			if err != nil {
+				return fmt.Errorf("policy for tag %s: %w", tag, err)
+			}
+		}
+		model.TagPolicies[tag] = policy
+	}
+	return nil
+}
+
+// exitOnErrors reports every error found in the source and exits, if there were any
+func exitOnErrors(errs []error) {
+	if len(errs) == 0 {
+		return
+	}
+	for _, err := range errs {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	os.Exit(1)
+}
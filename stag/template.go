@@ -0,0 +1,272 @@
+package main
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/bradleygore/go-stag/model"
+)
+
+// builtinTemplates are the templates stag comes with, selected by name: stag is the default output, const that of
+// the const style, docs is a markdown table of the fields, and columns holds the tag names as a comma-separated
+// list, i.e. for SQL selects
+//
+//go:embed templates
+var builtinTemplates embed.FS
+
+// defaultTemplate is generated when no -template is given
+const defaultTemplate = "stag"
+
+// helpersTemplate holds partials shared by every template, i.e. {{template "nestedType" .}}
+const helpersTemplate = "templates/helpers.tmpl"
+
+// stagTemplate is a template generating a file for each source file and tag
+type stagTemplate struct {
+	tmpl *template.Template
+	kind string // names its output when several templates are generated; the template's file name up to the ext
+	ext  string // ext of its output, from its file name, i.e. go for columns.go.tmpl or md for docs.md.tmpl
+
+	isDefault bool // the built-in default template, whose output keeps its file name along with other templates
+}
+
+// isGo reports whether the template generates Go code, which is formatted and has its imports fixed
+func (st *stagTemplate) isGo() bool {
+	return st.ext == "go"
+}
+
+// templateData is what a template is executed with, once for each source file and tag having structs to generate
+type templateData struct {
+	File      *model.File // the source file; BasePath, PkgName, PkgPath, BuildConstraint
+	Tag       string
+	NestSep   string // joins nested field paths; empty when nesting is disabled
+	OptionSep string // separates the key of a key/value option from its value, i.e. : for gorm
+	Structs   []templateStruct
+}
+
+// templateStruct is a struct to generate for. Its Fields are those it has for the tag, after policies,
+// directives and promotion from embeds, leaving out skipped ones; model.Structure's own Fields are shadowed.
+type templateStruct struct {
+	*model.Structure              // Name, PkgPath, Doc, TypeParams, Directives
+	Idents           structIdents // names of the generated identifiers, one for each of identKinds
+	Fields           []templateField
+	TagNames         []string        // tag names of the fields, in order
+	GoNames          []string        // Go names of the fields, in order
+	GoNameFields     []templateField // the first field of each Go name, i.e. for lookups by Go name
+	OptionFields     []templateField // fields having tag options, the first of each tag name
+}
+
+// templateField is a field of a struct for the tag. Ident is the name to give it in generated code.
+type templateField struct {
+	model.FieldTagName                 // FieldName, GoName, TagName, Options, Value, Type, Doc, Position, Tagged, Embedded
+	Path               string          // tag path of the field, joined by NestSep for nested fields
+	Source             string          // file:line of the field, relative to the generated file
+	Const              string          // name of the field's const in the const style; not set for nested fields
+	NestedFields       []templateField // fields of a struct-typed field, when generating nested objects
+}
+
+// templateFuncs are the helper funcs of templates, along with the case helpers of ident templates
+func templateFuncs(imports map[string]string) template.FuncMap {
+	funcs := template.FuncMap{
+		"quote":       quote,
+		"join":        strings.Join,
+		"comment":     commentText,
+		"stringSlice": stringSliceLiteral,
+		// import adds an import to generated Go code, i.e. {{import "github.com/google/uuid"}}, for pkgs goimports
+		// can't find on its own; an optional second arg names it. Unused imports are removed.
+		"import": func(path string, name ...string) string {
+			imports[path] = ""
+			if len(name) > 0 {
+				imports[path] = name[0]
+			}
+			return ""
+		},
+	}
+	for name, fn := range identFuncs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// loadTemplates parses the templates given as built-in names, template files and dirs of .tmpl files
+func loadTemplates(args []string) ([]*stagTemplate, error) {
+	if len(args) == 0 {
+		args = []string{defaultTemplate}
+	}
+	builtins, err := builtinTemplateNames()
+	if err != nil {
+		return nil, err
+	}
+	stagTmpls := []*stagTemplate{}
+	for _, arg := range args {
+		// a bare name is a built-in template, so a dir of templates named like one is given as ./name
+		if fileName, exists := builtins[arg]; exists && !strings.ContainsRune(arg, filepath.Separator) {
+			st, err := parseTemplateFile(builtinTemplates, "templates/"+fileName)
+			if err != nil {
+				return nil, err
+			}
+			st.isDefault = arg == defaultTemplate
+			stagTmpls = append(stagTmpls, st)
+			continue
+		}
+		fi, err := os.Stat(arg)
+		if err != nil {
+			names := []string{}
+			for name := range builtins {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return nil, fmt.Errorf("template %s is neither a file, a dir nor a built-in template; one of %s", arg, strings.Join(names, " | "))
+		}
+		if !fi.IsDir() {
+			st, err := parseTemplateFile(os.DirFS(filepath.Dir(arg)), filepath.Base(arg))
+			if err != nil {
+				return nil, err
+			}
+			stagTmpls = append(stagTmpls, st)
+			continue
+		}
+		entries, err := os.ReadDir(arg)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tmpl") {
+				continue
+			}
+			st, err := parseTemplateFile(os.DirFS(arg), entry.Name())
+			if err != nil {
+				return nil, err
+			}
+			stagTmpls = append(stagTmpls, st)
+		}
+	}
+	if len(stagTmpls) == 0 {
+		return nil, fmt.Errorf("no templates found in %s", strings.Join(args, ", "))
+	}
+	return stagTmpls, nil
+}
+
+// parseTemplateFile parses a template along with the shared partials, taking its kind and ext from its file name
+func parseTemplateFile(fsys fs.FS, name string) (*stagTemplate, error) {
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	helpers, err := builtinTemplates.ReadFile(helpersTemplate)
+	if err != nil {
+		return nil, err
+	}
+	base := strings.TrimSuffix(filepath.Base(name), ".tmpl")
+	st := &stagTemplate{kind: base, ext: "go"}
+	if ext := filepath.Ext(base); ext != "" {
+		st.kind, st.ext = strings.TrimSuffix(base, ext), ext[1:]
+	}
+	// funcs are bound again to each generator's imports before executing
+	tmpl := template.New(base).Funcs(templateFuncs(map[string]string{}))
+	if _, err := tmpl.New("helpers").Parse(string(helpers)); err != nil {
+		return nil, fmt.Errorf("template helpers: %w", err)
+	}
+	if st.tmpl, err = tmpl.Parse(string(content)); err != nil {
+		return nil, fmt.Errorf("template %s: %w", name, err)
+	}
+	return st, nil
+}
+
+// builtinTemplateNames returns the file names of the built-in templates by the name they're selected by, i.e. docs.md.tmpl by docs
+func builtinTemplateNames() (map[string]string, error) {
+	entries, err := builtinTemplates.ReadDir("templates")
+	if err != nil {
+		return nil, err
+	}
+	names := map[string]string{}
+	for _, entry := range entries {
+		if "templates/"+entry.Name() == helpersTemplate {
+			continue
+		}
+		base := strings.TrimSuffix(entry.Name(), ".tmpl")
+		names[strings.TrimSuffix(base, filepath.Ext(base))] = entry.Name()
+	}
+	return names, nil
+}
+
+// templateData returns the data to execute the generator's template with for strucs
+func (g *generator) templateData(strucs model.Structures) templateData {
+	data := templateData{File: g.file, Tag: g.tag, NestSep: g.nestSep, OptionSep: model.OptionSep(g.tag)}
+	for _, s := range strucs {
+		fields := s.FieldTagNames[g.tag]
+		ts := templateStruct{Structure: s, Idents: g.identsFor(s), TagNames: fields.TagNames()}
+		seen, seenGoNames := make(map[string]bool), make(map[string]bool)
+		for _, field := range fields {
+			if field.IsSkipped() {
+				continue
+			}
+			tf := g.templateField(field, field.TagName, map[*model.Structure]bool{s: true})
+			var err error
+			if tf.Const, err = g.idents.forField(s, g.tag, field); err != nil {
+				log.Fatal(err)
+			}
+			ts.Fields = append(ts.Fields, tf)
+			ts.GoNames = append(ts.GoNames, field.GoName())
+			if !seenGoNames[field.GoName()] {
+				seenGoNames[field.GoName()] = true
+				ts.GoNameFields = append(ts.GoNameFields, tf)
+			}
+			if len(field.Options) > 0 && !seen[field.TagName] {
+				seen[field.TagName] = true
+				ts.OptionFields = append(ts.OptionFields, tf)
+			}
+		}
+		data.Structs = append(data.Structs, ts)
+	}
+	return data
+}
+
+// templateField returns field as templates see it, having path as its full tag path
+func (g *generator) templateField(field model.FieldTagName, path string, visiting map[*model.Structure]bool) templateField {
+	tf := templateField{FieldTagName: field, Path: path, Source: g.sourcePosition(field.Position)}
+	nested := g.nestedFields(field, visiting)
+	if nested == nil {
+		return tf
+	}
+	visiting[field.Nested] = true
+	defer delete(visiting, field.Nested)
+	for _, f := range nested {
+		if !f.IsSkipped() {
+			tf.NestedFields = append(tf.NestedFields, g.templateField(f, path+g.nestSep+f.TagName, visiting))
+		}
+	}
+	return tf
+}
+
+// commentText returns text as a // comment, line by line
+func commentText(text string) string {
+	lines := []string{}
+	for _, line := range strings.Split(text, "\n") {
+		if line == "" {
+			lines = append(lines, "//")
+			continue
+		}
+		lines = append(lines, "// "+line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// quote returns v as a Go literal in double quotes, i.e. for strings or string types like model.Kind
+func quote(v interface{}) string {
+	return fmt.Sprintf("%q", v)
+}
+
+func stringSliceLiteral(vals []string) string {
+	quoted := make([]string, len(vals))
+	for idx, v := range vals {
+		quoted[idx] = fmt.Sprintf("%q", v)
+	}
+	return fmt.Sprintf("[]string{%s}", strings.Join(quoted, ","))
+}
@@ -12,6 +12,13 @@ var usageText = `stag generates static structs based on tag names for source str
 Example:
 	stag -source=path/to/foo.go -tags=json,db
 
+Or, for every pkg in the module:
+	stag -source=./... -exclude=testdata,vendor -tags=json,db
+
+Or, under go generate, where -source defaults to $GOFILE and a //go:generate line
+directly above a type generates only that type, into a file named after it, i.e. foo_bar.stag-json.go:
+	//go:generate stag -tags=json,db
+
 Given that source contains a struct like:
 type Foo struct {
 	Name string ` + usageBacktick(`json:"theName" db:"the_name"`) + `
@@ -37,6 +44,80 @@ var Foo_DB = struct{
 	Name: "the_name",
 	Flavor: "mmm_flavor",
 }
+
+With -style=const, each struct instead gets a string type with a const per field, usable in
+const expressions and switch cases, along with its Values, String, MarshalText, UnmarshalText
+and Parse funcs:
+
+//path/to/foo.stag_db.go
+type FooDBField string
+
+const (
+	FooDBFieldName   FooDBField = "the_name"
+	FooDBFieldFlavor FooDBField = "mmm_flavor"
+)
+
+func FooDBFieldValues() []FooDBField
+func ParseFooDBField(s string) (FooDBField, error)
+
+Output comes from text/templates: the built-in stag (the default), const, columns (the tag names
+as a comma-separated const, i.e. for SQL selects) or docs (a markdown table of the fields),
+or -template files and dirs of .tmpl files. A template's file name gives its output's ext,
+i.e. sql.go.tmpl makes Go code, formatted and with its imports fixed, and docs.md.tmpl
+markdown; with several templates, each output but the stag template's is named after its
+template, i.e. user.stag-db.sql.go. A template is executed once per source file and tag, with:
+	.File       BasePath, FileName, PkgName, PkgPath, BuildConstraint
+	.Tag        the tag being generated
+	.NestSep    the separator of nested paths; empty unless -nested
+	.OptionSep  the separator of an option's key and value, i.e. = or : for gorm
+	.Structs    each struct to generate, having its Name, Doc, TypeParams and Directives, and
+	  .Idents         Var, AllFieldNames, AllGoFieldNames, IsValid, GoNameFor, TagNameFor, Fields,
+	                  Options, HasOption, Type, Values, Parse; see -idents
+	  .TagNames       the tag names of its fields
+	  .GoNames        the Go names of its fields
+	  .GoNameFields   the fields, the first one of each Go name
+	  .Fields         each field for the tag, having FieldName, GoName (Base.Name when shadowed,
+	                  Author.Name when inlined), TagName, Ident, Const, Options, Value, Tagged, Embedded,
+	                  Promoted (the fields it's reached through), Doc, Source (file:line), Path (the full tag path),
+	                  Type (Expr, Kind, Nullable, PkgPath) and NestedFields (with -nested)
+	  .OptionFields   the fields having options, the first one of each tag name
+The helpers are quote, join, comment (text as // lines), stringSlice (a []string literal),
+import (adds an import, i.e. {{import "github.com/google/uuid"}}), upper, lower, pascal,
+camel, snake and kebab, along with the partials {{template "nestedType" .}} and
+{{template "nestedValue" .}} for a field.
+	stag -source=user.go -tags=db -template=stag,columns,./templates
+
+Directives in the doc comment of a struct or field tune its output in place:
+	//stag:generate json,db     generate the struct for these tags, instead of those given by -tags
+	//stag:skip [json,...]      leave out the struct or field, for every tag or only those listed
+	//stag:name db=legacy_col   override the name a tag gives the field
+	//stag:ident Foo            rename the generated Go identifier of the struct or field
+
+Generated identifiers are named by text/templates, given by -idents or the config, having
+.Struct and .Tag and the helpers upper, lower, pascal, camel, snake and kebab; i.e. for
+UserJSONFields and IsValidUserJSONField rather than User_JSON and IsValidUser_JSONField:
+	stag -source=user.go -tags=json -idents='var={{.Struct}}{{pascal .Tag}}Fields;isValid=IsValid{{.Struct}}{{pascal .Tag}}Field'
+
+A stag.yaml (or .stag.json) at the module root holds the same settings as the flags, which
+win over it when given, so a bare stag run is reproducible across the module:
+	tags: [json, db]
+	sources: [./...]
+	exclude: [testdata, vendor]
+	skipMarkers: ["-", "ignore"]
+	output:
+	  fileName: "{name}.stag-{tag}.go"
+	idents:
+	  var: "{{.Struct}}{{pascal .Tag}}Fields"
+	policies:
+	  db: {untagged: true, naming: snake}
+	dirs:
+	  internal/legacy:
+	    tags: [db]
+	    types: [Account*]
+	    policies:
+	      db: {naming: lower}
+	  internal/scratch:
+	    skip: true
 `
 
 func printUsage(printDefaultFlags bool) {
@@ -3,6 +3,8 @@ package main
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
+	"go/types"
 	"log"
 	"strconv"
 	"strings"
@@ -13,6 +15,9 @@ import (
 type visitor struct {
 	depth int
 	file  *model.File
+	info  *types.Info // type-checked view of the file's pkg, used to resolve embeds
+	fset  *token.FileSet
+	errs  *[]error // problems found in the source, reported together once walking is done
 }
 
 func (v visitor) Visit(n ast.Node) ast.Visitor {
@@ -100,35 +105,123 @@ func (v visitor) processFile(f *model.File, astf *ast.File) {
 						Alias:   importName,
 					})
 				case *ast.TypeSpec:
+					structDoc := node.Doc
+					if structDoc == nil && len(decNode.Specs) == 1 {
+						structDoc = decNode.Doc
+					}
 					if struc, ok := node.Type.(*ast.StructType); ok {
-						fStruct := &model.Structure{Name: node.Name.String()}
+						fStruct := &model.Structure{Name: node.Name.String(), PkgPath: f.PkgPath, Doc: docOf(structDoc), Directives: v.directivesOf(structDoc)}
+						if node.TypeParams != nil {
+							for _, param := range node.TypeParams.List {
+								for _, name := range param.Names {
+									fStruct.TypeParams = append(fStruct.TypeParams, name.Name)
+								}
+							}
+						}
+						fieldIdx := 0
 						for _, field := range struc.Fields.List {
-							if v.identNames(field.Names) == "" {
-								// dealing with embed
-								if selectorExp, ok := field.Type.(*ast.SelectorExpr); ok {
-									// embedding a type from imported pkg
-									fStruct.ImportEmbeds = append(fStruct.ImportEmbeds, model.ImportEmbed{
-										PkgName:    selectorExp.X.(*ast.Ident).Name,
-										StructName: selectorExp.Sel.Name,
-									})
-								} else {
+							fieldNames := field.Names
+							embedded := v.identNames(field.Names) == ""
+							if embedded {
+								// dealing with embed, which may be a pointer and/or a generic instantiation
+								embedPkgPath, embedName := v.embedRefOf(field.Type)
+								var embedType types.Type
+								if v.info != nil {
+									embedType = v.info.TypeOf(field.Type)
+								}
+								switch {
+								case embedPkgPath == "":
+									// not a struct, so there are no fields to promote; it's a regular field named after its type, as encoding/json has it
+									embedded = false
+									if ident := embedIdent(field.Type); ident != nil {
+										embedName = ident.Name
+									}
+								case embedPkgPath != v.file.PkgPath || isInstance(embedType):
+									// embedding a type from imported pkg, or an instantiated generic whose fields depend on its type args
+									ie := model.ImportEmbed{FullyQualifiedPkgName: embedPkgPath, StructName: embedName, Type: embedType}
+									if selectorExp, ok := unwrapTypeExpr(field.Type).(*ast.SelectorExpr); ok {
+										ie.PkgName = selectorExp.X.(*ast.Ident).Name
+									}
+									fStruct.ImportEmbeds = append(fStruct.ImportEmbeds, ie)
+								default:
 									// embedding a type local to the pakg
-									fStruct.EmbedNames = append(fStruct.EmbedNames, fmt.Sprintf("%v", field.Type))
+									fStruct.EmbedNames = append(fStruct.EmbedNames, embedName)
+								}
+								// a tagged embed is named after its type, and may be a regular field for that tag
+								namePos := field.Type.Pos()
+								if ident := embedIdent(field.Type); ident != nil {
+									// where the type checker places the field, i.e. at Base rather than at the star of *pkg.Base
+									namePos = ident.Pos()
 								}
-								continue
+								fieldNames = []*ast.Ident{{Name: embedName, NamePos: namePos}}
 							}
-							if field.Tag == nil {
-								continue
+							nestedPkgPath, nestedName := "", ""
+							var nestedType types.Type
+							fieldType := model.FieldType{Expr: fmt.Sprintf("%v", field.Type)}
+							if v.info != nil {
+								nestedPkgPath, nestedName = structRefOf(v.info.TypeOf(field.Type))
+								nestedType = nestedTypeOf(v.info.TypeOf(field.Type))
+								fieldType = fieldTypeOf(v.info.TypeOf(field.Type), qualifierFor(v.file.PkgPath))
 							}
-							fieldName := field.Names[0].String()
-							tags := v.parseFieldTag(field.Tag.Value, fieldName)
-							if len(tags) > 0 {
-								for tagName, tagFieldName := range tags {
-									fStruct.AddFieldTagName(tagName, fieldName, tagFieldName)
+							directives := v.directivesOf(field.Doc, field.Comment)
+							rawTag := ""
+							if field.Tag != nil {
+								var err error
+								if rawTag, err = strconv.Unquote(field.Tag.Value); err != nil {
+									v.reportErr(field.Tag.Pos(), err)
+									continue
+								}
+							}
+							// a grouped declaration like `First, Last string` applies its tag to every name
+							for _, name := range fieldNames {
+								fieldName := name.String()
+								fieldIdx++
+								goField := model.FieldTagName{
+									FieldName:     fieldName,
+									TagName:       fieldName,
+									Position:      v.position(name.Pos()),
+									Type:          fieldType,
+									Doc:           docOf(field.Doc, field.Comment),
+									Directives:    directives,
+									Index:         fieldIdx,
+									Embedded:      embedded,
+									NestedPkgPath: nestedPkgPath,
+									NestedName:    nestedName,
+									NestedType:    nestedType,
+								}
+								if embedded {
+									fStruct.AddEmbedIndex(fieldName, fieldIdx)
+									fStruct.Embeds = append(fStruct.Embeds, goField)
+								} else {
+									fStruct.Fields = append(fStruct.Fields, goField)
+								}
+								tags, err := v.parseFieldTag(rawTag, fieldName)
+								if err != nil {
+									v.reportErr(field.Tag.Pos(), fmt.Errorf("field %s.%s: %w", fStruct.Name, fieldName, err))
+									break
+								}
+								// a //stag:name directive names the field for a tag it doesn't carry
+								for tagName := range directives.Names {
+									if _, exists := tags[tagName]; !exists {
+										tags[tagName] = model.FieldTagName{FieldName: fieldName, TagName: fieldName}
+									}
+								}
+								for tagName, ftn := range tags {
+									ftn.Position = v.position(name.Pos())
+									ftn.Embedded = embedded
+									ftn.NestedPkgPath, ftn.NestedName, ftn.NestedType = nestedPkgPath, nestedName, nestedType
+									ftn.Type = fieldType
+									ftn.Doc = docOf(field.Doc, field.Comment)
+									ftn.Directives = directives
+									ftn.Index = fieldIdx
+									fStruct.AddFieldTag(tagName, ftn)
 								}
 							}
 						}
 						f.Structs = append(f.Structs, fStruct)
+					} else if v.isStructType(node.Name) {
+						// defined type or alias of another struct, i.e. `type Admin User`; its fields are loaded from the type checker
+						f.Structs = append(f.Structs, &model.Structure{Name: node.Name.String(), PkgPath: f.PkgPath, Doc: docOf(structDoc), Directives: v.directivesOf(structDoc), FromType: true})
 					}
 				}
 			}
@@ -136,24 +229,125 @@ func (v visitor) processFile(f *model.File, astf *ast.File) {
 	}
 }
 
-func (v visitor) parseFieldTag(tag, structFieldName string) map[string]string {
-	tagNames := make(map[string]string)
-	tags := strings.Split(strings.ReplaceAll(tag, "`", ""), " ")
-	for _, t := range tags {
-		colon := strings.IndexRune(t, ':')
-		tagName, tagVal := t[:colon], t[colon+1:]
-		tagFieldName := strings.SplitN(tagVal, ",", 1)[0]
-		var err error
-		if tagFieldName, err = strconv.Unquote(tagFieldName); err != nil {
-			log.Fatalf("err unquoting tagFieldName %s: %v", tagFieldName, err)
+// embedRefOf returns the pkg path and name of the struct an embedded field's type refers to. Without
+// type info the name comes from the syntax alone, and the pkg path is only known for local types.
+func (v visitor) embedRefOf(expr ast.Expr) (pkgPath, name string) {
+	if v.info != nil {
+		return structRefOf(v.info.TypeOf(expr))
+	}
+	switch node := unwrapTypeExpr(expr).(type) {
+	case *ast.Ident:
+		return v.file.PkgPath, node.Name
+	case *ast.SelectorExpr:
+		return "", node.Sel.Name
+	}
+	return "", ""
+}
+
+// embedIdent returns the identifier naming an embedded field's type, i.e. Base for *pkg.Base[T]
+func embedIdent(expr ast.Expr) *ast.Ident {
+	switch node := unwrapTypeExpr(expr).(type) {
+	case *ast.Ident:
+		return node
+	case *ast.SelectorExpr:
+		return node.Sel
+	}
+	return nil
+}
+
+// docOf joins the text of the comment groups, leaving out any that are missing
+func docOf(groups ...*ast.CommentGroup) string {
+	docs := []string{}
+	for _, group := range groups {
+		if text := strings.TrimSpace(group.Text()); text != "" {
+			docs = append(docs, text)
 		}
-		// some tags use commas to separate values, by convention name comes first
-		tagFieldName = strings.Split(tagFieldName, ",")[0]
-		if len(tagFieldName) == 0 {
-			tagFieldName = structFieldName
+	}
+	return strings.Join(docs, "\n")
+}
+
+// directivesOf parses the //stag: directives out of the comment groups, leaving out any that are missing
+func (v visitor) directivesOf(groups ...*ast.CommentGroup) model.Directives {
+	directives := model.Directives{}
+	for _, group := range groups {
+		if group == nil {
+			continue
+		}
+		for _, c := range group.List {
+			if err := directives.Add(c.Text); err != nil {
+				v.reportErr(c.Pos(), err)
+			}
 		}
-		tagNames[tagName] = tagFieldName
 	}
+	return directives
+}
+
+// isStructType reports whether the type declared by name has a struct as its underlying type
+func (v visitor) isStructType(name *ast.Ident) bool {
+	if v.info == nil {
+		return false
+	}
+	obj, ok := v.info.Defs[name].(*types.TypeName)
+	if !ok {
+		return false
+	}
+	_, ok = obj.Type().Underlying().(*types.Struct)
+	return ok
+}
+
+// unwrapTypeExpr strips the pointer and type arguments from an embedded type, i.e. *pkg.Base[T] to pkg.Base
+func unwrapTypeExpr(expr ast.Expr) ast.Expr {
+	for {
+		switch node := expr.(type) {
+		case *ast.StarExpr:
+			expr = node.X
+		case *ast.ParenExpr:
+			expr = node.X
+		case *ast.IndexExpr:
+			expr = node.X
+		case *ast.IndexListExpr:
+			expr = node.X
+		default:
+			return expr
+		}
+	}
+}
 
-	return tagNames
+// parseFieldTag maps each tag key on a field to the name and options it gives the field; tag is the unquoted tag content
+func (v visitor) parseFieldTag(tag, structFieldName string) (map[string]model.FieldTagName, error) {
+	tags, err := model.ParseTags(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	tagNames := make(map[string]model.FieldTagName)
+	for _, key := range tags.Keys() {
+		tagVal, _ := tags.Lookup(key)
+		name, options := model.SplitTagValue(key, tagVal)
+		ftn := model.FieldTagName{FieldName: structFieldName, TagName: name, Tagged: name != "", Value: tagVal, Skipped: model.IsSkipMarker(tagVal)}
+		if len(ftn.TagName) == 0 {
+			ftn.TagName = structFieldName
+		}
+		if len(options) > 0 {
+			ftn.Options = options
+		}
+		tagNames[key] = ftn
+	}
+
+	return tagNames, nil
+}
+
+func (v visitor) position(pos token.Pos) token.Position {
+	if v.fset == nil {
+		return token.Position{}
+	}
+	return v.fset.Position(pos)
+}
+
+// reportErr records err against the source position it was found at, so every problem can be reported at once
+func (v visitor) reportErr(pos token.Pos, err error) {
+	if v.errs == nil {
+		log.Fatal(err)
+	}
+	*v.errs = append(*v.errs, fmt.Errorf("%s: %w", v.fset.Position(pos), err))
 }